@@ -0,0 +1,113 @@
+package masker
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// StructDiff mask the struct like Struct(), and return the paths of the fields whose values are changed by masking,
+// like "Name", "Contact.Email", "Kids[0].Name" or "Phones[home]"
+//
+// Example:
+//
+//	t, changed, err := m.StructDiff(&Foo{Name: "ggwhite", Age: 18})
+//
+//	fmt.Println(changed) // [Name]
+func (m *Masker) StructDiff(s interface{}) (masked interface{}, changed []string, err error) {
+	masked, err = m.Struct(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	changed = []string{}
+	m.diff(reflect.Indirect(reflect.ValueOf(s)), reflect.ValueOf(masked).Elem(), "", &changed)
+	return masked, changed, nil
+}
+
+func (m *Masker) diff(a, b reflect.Value, path string, changed *[]string) {
+	for a.Kind() == reflect.Ptr || a.Kind() == reflect.Interface {
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				*changed = append(*changed, path)
+			}
+			return
+		}
+		a, b = a.Elem(), b.Elem()
+		if a.Type() != b.Type() {
+			*changed = append(*changed, path)
+			return
+		}
+	}
+
+	switch a.Kind() {
+	case reflect.Struct:
+		if opaque(a.Type()) {
+			// the unexported fields can not be traversed, like the ones of time.Time, the whole value is compared
+			if a.CanInterface() && !reflect.DeepEqual(a.Interface(), b.Interface()) {
+				*changed = append(*changed, path)
+			}
+			return
+		}
+		for i := 0; i < a.NumField(); i++ {
+			f := a.Type().Field(i)
+			if len(f.PkgPath) > 0 {
+				continue
+			}
+			p, ok := m.fieldKey(f)
+			if !ok {
+				p = f.Name
+			}
+			if len(path) > 0 {
+				p = path + "." + p
+			}
+			m.diff(a.Field(i), b.Field(i), p, changed)
+		}
+		return
+	case reflect.Slice, reflect.Array:
+		if a.Kind() == reflect.Slice && (a.IsNil() != b.IsNil() || a.Len() != b.Len()) {
+			*changed = append(*changed, path)
+			return
+		}
+		for j := 0; j < a.Len(); j++ {
+			m.diff(a.Index(j), b.Index(j), fmt.Sprintf("%s[%d]", path, j), changed)
+		}
+		return
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() || a.Len() != b.Len() {
+			*changed = append(*changed, path)
+			return
+		}
+		keys := a.MapKeys()
+		sort.Slice(keys, func(x, y int) bool {
+			return fmt.Sprint(keys[x].Interface()) < fmt.Sprint(keys[y].Interface())
+		})
+		for _, k := range keys {
+			p := fmt.Sprintf("%s[%v]", path, k.Interface())
+			if !b.MapIndex(k).IsValid() {
+				*changed = append(*changed, p)
+				continue
+			}
+			m.diff(a.MapIndex(k), b.MapIndex(k), p, changed)
+		}
+		return
+	}
+
+	if a.CanInterface() && !reflect.DeepEqual(a.Interface(), b.Interface()) {
+		*changed = append(*changed, path)
+	}
+}
+
+// opaque report whether the struct type has an unexported field
+func opaque(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if len(t.Field(i).PkgPath) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// StructDiff mask the struct like Struct(), and return the paths of the fields whose values are changed by masking
+func StructDiff(s interface{}) (masked interface{}, changed []string, err error) {
+	return defaultMasker().StructDiff(s)
+}
@@ -0,0 +1,133 @@
+package masker
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMasker_StructDiff(t *testing.T) {
+	type Contact struct {
+		Email  string `mask:"email"`
+		Mobile string `mask:"mobile"`
+	}
+	type User struct {
+		Name     string `mask:"name"`
+		Nickname string
+		Age      int
+		Contact  *Contact          `mask:"struct"`
+		Kids     []Contact         `mask:"struct"`
+		Phones   map[string]string `mask:"mobile"`
+		Backup   Contact
+	}
+
+	type args struct {
+		s interface{}
+	}
+	tests := []struct {
+		name        string
+		m           *Masker
+		args        args
+		wantChanged []string
+		wantErr     bool
+	}{
+		{
+			name: "Nil Input",
+			m:    New(),
+			args: args{
+				s: nil,
+			},
+			wantChanged: nil,
+			wantErr:     true,
+		},
+		{
+			name: "Partially Tagged",
+			m:    New(),
+			args: args{
+				s: &User{
+					Name:     "ggwhite",
+					Nickname: "ggwhite",
+					Age:      18,
+					Contact: &Contact{
+						Email: "ggw.chang@gmail.com",
+					},
+					Kids: []Contact{
+						{Mobile: "0987654321"},
+						{},
+					},
+					Phones: map[string]string{
+						"home":   "0912345678",
+						"office": "",
+					},
+					Backup: Contact{
+						Email: "ggw.chang@gmail.com",
+					},
+				},
+			},
+			wantChanged: []string{"Name", "Contact.Email", "Kids[0].Mobile", "Phones[home]"},
+			wantErr:     false,
+		},
+		{
+			name: "Nothing Changed",
+			m:    New(),
+			args: args{
+				s: User{
+					Nickname: "ggwhite",
+				},
+			},
+			wantChanged: []string{},
+			wantErr:     false,
+		},
+		{
+			name: "JSON Keys",
+			m:    New(WithJSONKeys()),
+			args: args{
+				s: &struct {
+					Contact Contact `json:"contact" mask:"struct"`
+					Email   string  `json:"email_address" mask:"email"`
+				}{
+					Contact: Contact{Mobile: "0987654321"},
+					Email:   "ggw.chang@gmail.com",
+				},
+			},
+			wantChanged: []string{"contact.Mobile", "email_address"},
+			wantErr:     false,
+		},
+		{
+			name: "Opaque Struct",
+			m:    truncateTime(New()),
+			args: args{
+				s: &struct {
+					Email     string `mask:"email"`
+					CreatedAt time.Time
+					UpdatedAt time.Time
+				}{
+					Email:     "ggw.chang@gmail.com",
+					CreatedAt: time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			wantChanged: []string{"Email", "CreatedAt"},
+			wantErr:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, changed, err := tt.m.StructDiff(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Masker.StructDiff() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(changed, tt.wantChanged) {
+				t.Errorf("Masker.StructDiff() changed = %v, want %v", changed, tt.wantChanged)
+			}
+		})
+	}
+}
+
+func truncateTime(m *Masker) *Masker {
+	m.RegisterTypeMasker(reflect.TypeOf(time.Time{}), func(v reflect.Value) reflect.Value {
+		return reflect.ValueOf(v.Interface().(time.Time).Truncate(24 * time.Hour))
+	})
+	return m
+}
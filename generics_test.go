@@ -0,0 +1,83 @@
+//go:build go1.18
+
+package masker
+
+import (
+	"reflect"
+	"testing"
+)
+
+type genericBox[T any] struct {
+	Value T      `mask:"name"`
+	Email string `mask:"email"`
+}
+
+type genericPair[K comparable, V any] struct {
+	Key   K                   `mask:"id"`
+	Items []genericBox[V]     `mask:"struct"`
+	Box   *genericBox[string] `mask:"struct"`
+}
+
+func TestMasker_Struct_Generics(t *testing.T) {
+	type args struct {
+		s interface{}
+	}
+	tests := []struct {
+		name    string
+		m       *Masker
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "String Instance",
+			m:    New(),
+			args: args{
+				s: &genericBox[string]{Value: "ggwhite", Email: "ggw.chang@gmail.com"},
+			},
+			want:    &genericBox[string]{Value: "g**hite", Email: "ggw****ng@gmail.com"},
+			wantErr: false,
+		},
+		{
+			name: "Int Instance",
+			m:    New(),
+			args: args{
+				s: genericBox[int]{Value: 18, Email: "qq@gmail.com"},
+			},
+			want:    &genericBox[int]{Value: 18, Email: "qq****@gmail.com"},
+			wantErr: false,
+		},
+		{
+			name: "Nested Instances",
+			m:    New(),
+			args: args{
+				s: &genericPair[string, string]{
+					Key:   "A123456789",
+					Items: []genericBox[string]{{Value: "ggwhite"}},
+					Box:   &genericBox[string]{Email: "qq@gmail.com"},
+				},
+			},
+			want: &genericPair[string, string]{
+				Key:   "A12345****",
+				Items: []genericBox[string]{{Value: "g**hite"}},
+				Box:   &genericBox[string]{Email: "qq****@gmail.com"},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Struct(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Masker.Struct() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if reflect.TypeOf(got) != reflect.TypeOf(tt.want) {
+				t.Errorf("Masker.Struct() type = %T, want %T", got, tt.want)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Masker.Struct() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package masker
+
+import (
+	"unicode"
+)
+
+// WithGraphemeClusters make the Masker count the letters by grapheme cluster instead of rune,
+// so an emoji of several runes, like a flag or an emoji with a skin-tone modifier, is kept or masked as one letter.
+// It applies to String(), Struct() and the methods of the mask types like Name() and Email(),
+// and to PartialMask(), Balanced(), Secret(), Pattern() and IDKeep().
+//
+// Example:
+//
+//	m := masker.New(masker.WithGraphemeClusters())
+//	m.String(masker.MHandle, "@👍🏽abc") // @👍🏽***
+func WithGraphemeClusters() Option {
+	return func(m *Masker) {
+		m.graphemes = true
+	}
+}
+
+const (
+	zeroWidthJoiner = '\u200d'
+	// clusterBase is the first rune of the supplementary private use area A,
+	// the clusters of several runes are replaced with the runes from it while masking
+	clusterBase = 0xf0000
+	clusterMax  = 0xffffd
+)
+
+// graphemeClusters split the input into the grapheme clusters, a simplified segmentation of UAX #29
+// which joins the combining marks, the variation selectors, the emoji modifiers, the tags,
+// the runes joined by a zero width joiner and the pairs of regional indicators (flags)
+func graphemeClusters(s string) []string {
+	r := []rune(s)
+	clusters := []string{}
+	for i := 0; i < len(r); {
+		j := i + 1
+		if isRegionalIndicator(r[i]) && j < len(r) && isRegionalIndicator(r[j]) {
+			j++
+		}
+		for j < len(r) && (isGraphemeExtend(r[j]) || r[j-1] == zeroWidthJoiner) {
+			j++
+		}
+		clusters = append(clusters, string(r[i:j]))
+		i = j
+	}
+	return clusters
+}
+
+func isRegionalIndicator(c rune) bool {
+	return c >= 0x1f1e6 && c <= 0x1f1ff
+}
+
+// isGraphemeExtend report whether the rune extends the cluster before it
+func isGraphemeExtend(c rune) bool {
+	switch {
+	case c == zeroWidthJoiner:
+		return true
+	case c >= 0xfe00 && c <= 0xfe0f, c >= 0xe0100 && c <= 0xe01ef: // variation selectors
+		return true
+	case c >= 0x1f3fb && c <= 0x1f3ff: // emoji modifiers
+		return true
+	case c >= 0xe0020 && c <= 0xe007f: // tags
+		return true
+	}
+	return unicode.In(c, unicode.Mn, unicode.Me, unicode.Mc)
+}
+
+// byClusters mask the input with mask, replacing each cluster of several runes with a single rune before,
+// and putting the clusters back in the output. The input which already has the runes of the private use area
+// is masked as it is.
+func byClusters(i string, mask func(string) string) string {
+	clusters := graphemeClusters(i)
+	multi := []string{}
+	enc := make([]rune, 0, len(clusters))
+	for _, c := range clusters {
+		r := []rune(c)
+		if len(r) == 1 {
+			if r[0] >= clusterBase && r[0] <= clusterMax {
+				return mask(i)
+			}
+			enc = append(enc, r[0])
+			continue
+		}
+		if clusterBase+len(multi) > clusterMax {
+			return mask(i)
+		}
+		enc = append(enc, rune(clusterBase+len(multi)))
+		multi = append(multi, c)
+	}
+	if len(multi) == 0 {
+		return mask(i)
+	}
+
+	out := []rune(mask(string(enc)))
+	ans := make([]rune, 0, len(out))
+	for _, c := range out {
+		if idx := int(c) - clusterBase; idx >= 0 && idx < len(multi) {
+			ans = append(ans, []rune(multi[idx])...)
+			continue
+		}
+		ans = append(ans, c)
+	}
+	return string(ans)
+}
+
+// clustered mask i with fn by grapheme cluster, fn is called with a copy of the Masker without the option
+func (m *Masker) clustered(i string, fn func(m *Masker, i string) string) string {
+	raw := *m
+	raw.graphemes = false
+	return byClusters(i, func(s string) string { return fn(&raw, s) })
+}
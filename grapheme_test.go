@@ -0,0 +1,172 @@
+package masker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_graphemeClusters(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "Empty Input",
+			args: args{s: ""},
+			want: []string{},
+		},
+		{
+			name: "ASCII",
+			args: args{s: "abc"},
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "Flags",
+			args: args{s: "🇹🇼🇯🇵"},
+			want: []string{"🇹🇼", "🇯🇵"},
+		},
+		{
+			name: "Skin Tone",
+			args: args{s: "👍🏽a"},
+			want: []string{"👍🏽", "a"},
+		},
+		{
+			name: "Zero Width Joiner",
+			args: args{s: "👨\u200d👩\u200d👧!"},
+			want: []string{"👨\u200d👩\u200d👧", "!"},
+		},
+		{
+			name: "Combining Mark",
+			args: args{s: "e\u0301t"},
+			want: []string{"e\u0301", "t"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := graphemeClusters(tt.args.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("graphemeClusters() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithGraphemeClusters(t *testing.T) {
+	type args struct {
+		t mtype
+		i string
+	}
+	tests := []struct {
+		name string
+		m    *Masker
+		args args
+		want string
+	}{
+		{
+			name: "Flag",
+			m:    New(WithGraphemeClusters()),
+			args: args{
+				t: MHandle,
+				i: "🇹🇼abc",
+			},
+			want: "🇹🇼***",
+		},
+		{
+			name: "Flag Without Option",
+			m:    New(),
+			args: args{
+				t: MHandle,
+				i: "🇹🇼abc",
+			},
+			want: "🇹****",
+		},
+		{
+			name: "Skin Tone",
+			m:    New(WithGraphemeClusters()),
+			args: args{
+				t: MHandle,
+				i: "@👍🏽abc",
+			},
+			want: "@👍🏽***",
+		},
+		{
+			name: "Skin Tone Without Option",
+			m:    New(),
+			args: args{
+				t: MHandle,
+				i: "@👍🏽abc",
+			},
+			want: "@👍****",
+		},
+		{
+			name: "Full",
+			m:    New(WithGraphemeClusters()),
+			args: args{
+				t: MFull,
+				i: "🇹🇼👍🏽",
+			},
+			want: "**",
+		},
+		{
+			name: "Private Use Input",
+			m:    New(WithGraphemeClusters()),
+			args: args{
+				t: MFull,
+				i: "\U000f0000🇹🇼",
+			},
+			want: "***",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.String(tt.args.t, tt.args.i); got != tt.want {
+				t.Errorf("Masker.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithGraphemeClusters_Methods(t *testing.T) {
+	m := New(WithGraphemeClusters())
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{
+			name: "Name",
+			got:  m.Name("👍🏽王小明"),
+			want: "👍🏽**明",
+		},
+		{
+			name: "Handle",
+			got:  m.Handle("@👍🏽abc"),
+			want: "@👍🏽***",
+		},
+		{
+			name: "Full",
+			got:  m.Full("🇹🇼👍🏽"),
+			want: "**",
+		},
+		{
+			name: "PartialMask",
+			got:  m.PartialMask("🇹🇼abcd", 2, KeepPrefix),
+			want: "🇹🇼a***",
+		},
+		{
+			name: "IDKeep",
+			got:  m.IDKeep("🇹🇼123456", 2),
+			want: "🇹🇼1****",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
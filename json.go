@@ -0,0 +1,92 @@
+package masker
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+var (
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+	numberType     = reflect.TypeOf(json.Number(""))
+)
+
+// MaskJSON mask every string value in the JSON document with the mask type, the object keys are kept
+//
+// Example:
+//
+//	input: {"user":{"email":"ggw.chang@gmail.com"}}, MEmail
+//	output: {"user":{"email":"ggw****ng@gmail.com"}}
+func (m *Masker) MaskJSON(data []byte, t mtype) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(m.maskJSONValue(v, t)); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// Lazy return a json.Marshaler of the struct, the struct is masked by Struct() only when MarshalJSON is called,
+// so nothing is masked if it's never marshaled, like a log line filtered out by the level
+//
+// Example:
+//
+//	b, err := json.Marshal(m.Lazy(user)) // user is masked here
+func (m *Masker) Lazy(s interface{}) json.Marshaler {
+	return &lazy{m: m, s: s}
+}
+
+// lazy mask the struct when it's marshaled
+type lazy struct {
+	m *Masker
+	s interface{}
+}
+
+// MarshalJSON mask the struct and marshal the result
+func (l *lazy) MarshalJSON() ([]byte, error) {
+	t, err := l.m.Struct(l.s)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(t)
+}
+
+func (m *Masker) maskJSONValue(v interface{}, t mtype) interface{} {
+	switch val := v.(type) {
+	case string:
+		return m.String(t, val)
+	case []interface{}:
+		for idx := range val {
+			val[idx] = m.maskJSONValue(val[idx], t)
+		}
+	case map[string]interface{}:
+		for k := range val {
+			val[k] = m.maskJSONValue(val[k], t)
+		}
+	}
+	return v
+}
+
+// MaskJSON mask every string value in the JSON document with the mask type, the object keys are kept
+//
+// Example:
+//
+//	input: {"user":{"email":"ggw.chang@gmail.com"}}, MEmail
+//	output: {"user":{"email":"ggw****ng@gmail.com"}}
+func MaskJSON(data []byte, t mtype) ([]byte, error) {
+	return defaultMasker().MaskJSON(data, t)
+}
+
+// Lazy return a json.Marshaler of the struct, the struct is masked by Struct() only when MarshalJSON is called
+func Lazy(s interface{}) json.Marshaler {
+	return defaultMasker().Lazy(s)
+}
@@ -0,0 +1,199 @@
+package masker
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMasker_MaskJSON(t *testing.T) {
+	type args struct {
+		data []byte
+		t    mtype
+	}
+	tests := []struct {
+		name    string
+		m       *Masker
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Invalid JSON",
+			m:    New(),
+			args: args{
+				data: []byte(`{"email":`),
+				t:    MEmail,
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "Nested Object",
+			m:    New(),
+			args: args{
+				data: []byte(`{"user":{"email":"ggw.chang@gmail.com","age":18,"emails":["qq@gmail.com",null]}}`),
+				t:    MEmail,
+			},
+			want:    `{"user":{"age":18,"email":"ggw****ng@gmail.com","emails":["qq****@gmail.com",null]}}`,
+			wantErr: false,
+		},
+		{
+			name: "String",
+			m:    New(),
+			args: args{
+				data: []byte(`"0987654321"`),
+				t:    MMobile,
+			},
+			want:    `"0987***321"`,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.MaskJSON(tt.args.data, tt.args.t)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Masker.MaskJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("Masker.MaskJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMasker_Struct_RawMessage(t *testing.T) {
+	type Event struct {
+		Payload json.RawMessage `mask:"email"`
+		Empty   json.RawMessage `mask:"email"`
+		Raw     json.RawMessage
+	}
+
+	type args struct {
+		s interface{}
+	}
+	tests := []struct {
+		name    string
+		m       *Masker
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "Mask JSON Content",
+			m:    New(),
+			args: args{
+				s: &Event{
+					Payload: json.RawMessage(`{"user":{"name":"ggwhite","email":"ggw.chang@gmail.com"}}`),
+					Raw:     json.RawMessage(`{"email":"ggw.chang@gmail.com"}`),
+				},
+			},
+			want: &Event{
+				Payload: json.RawMessage(`{"user":{"email":"ggw****ng@gmail.com","name":"ggw****"}}`),
+				Raw:     json.RawMessage(`{"email":"ggw.chang@gmail.com"}`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid JSON",
+			m:    New(),
+			args: args{
+				s: &Event{
+					Payload: json.RawMessage(`{"user":`),
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Struct(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Masker.Struct() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Masker.Struct() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMasker_Struct_Number(t *testing.T) {
+	type Order struct {
+		Amount json.Number `mask:"full"`
+		Count  json.Number
+	}
+
+	type args struct {
+		s interface{}
+	}
+	tests := []struct {
+		name    string
+		m       *Masker
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "Tagged Number",
+			m:    New(),
+			args: args{
+				s: &Order{Amount: "1024.50", Count: "3"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Empty And Untagged Number",
+			m:    New(),
+			args: args{
+				s: &Order{Count: "3"},
+			},
+			want:    &Order{Count: "3"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Struct(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Masker.Struct() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Masker.Struct() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMasker_Lazy(t *testing.T) {
+	type User struct {
+		Name  string `mask:"name"`
+		Email string `mask:"email"`
+	}
+
+	u := &User{Name: "ggwhite", Email: "ggw.chang@gmail.com"}
+	lz := New().Lazy(u)
+
+	// changed before marshaling, the change must be in the output
+	u.Email = "qq@gmail.com"
+
+	got, err := json.Marshal(map[string]interface{}{"user": lz})
+	if err != nil {
+		t.Fatalf("Masker.Lazy() error = %v", err)
+	}
+	if want := `{"user":{"Name":"g**hite","Email":"qq****@gmail.com"}}`; string(got) != want {
+		t.Errorf("Masker.Lazy() = %s, want %s", got, want)
+	}
+	if u.Name != "ggwhite" || u.Email != "qq@gmail.com" {
+		t.Errorf("Masker.Lazy() changed the input, got %v", u)
+	}
+
+	if _, err := json.Marshal(New().Lazy(nil)); err == nil {
+		t.Errorf("Masker.Lazy() error = %v, wantErr %v", err, true)
+	}
+}
@@ -0,0 +1,788 @@
+package masker
+
+// compositions map a base letter and a combining diacritical mark (U+0300 to U+036F)
+// to the precomposed letter, taken from the canonical decompositions of the Unicode
+// Character Database (14.0.0) for U+00C0 to U+1FFF, covering Latin, Greek and Cyrillic.
+var compositions = map[[2]rune]rune{
+	{0x0041, 0x0300}: 0x00C0, // À
+	{0x0041, 0x0301}: 0x00C1, // Á
+	{0x0041, 0x0302}: 0x00C2, // Â
+	{0x0041, 0x0303}: 0x00C3, // Ã
+	{0x0041, 0x0308}: 0x00C4, // Ä
+	{0x0041, 0x030A}: 0x00C5, // Å
+	{0x0043, 0x0327}: 0x00C7, // Ç
+	{0x0045, 0x0300}: 0x00C8, // È
+	{0x0045, 0x0301}: 0x00C9, // É
+	{0x0045, 0x0302}: 0x00CA, // Ê
+	{0x0045, 0x0308}: 0x00CB, // Ë
+	{0x0049, 0x0300}: 0x00CC, // Ì
+	{0x0049, 0x0301}: 0x00CD, // Í
+	{0x0049, 0x0302}: 0x00CE, // Î
+	{0x0049, 0x0308}: 0x00CF, // Ï
+	{0x004E, 0x0303}: 0x00D1, // Ñ
+	{0x004F, 0x0300}: 0x00D2, // Ò
+	{0x004F, 0x0301}: 0x00D3, // Ó
+	{0x004F, 0x0302}: 0x00D4, // Ô
+	{0x004F, 0x0303}: 0x00D5, // Õ
+	{0x004F, 0x0308}: 0x00D6, // Ö
+	{0x0055, 0x0300}: 0x00D9, // Ù
+	{0x0055, 0x0301}: 0x00DA, // Ú
+	{0x0055, 0x0302}: 0x00DB, // Û
+	{0x0055, 0x0308}: 0x00DC, // Ü
+	{0x0059, 0x0301}: 0x00DD, // Ý
+	{0x0061, 0x0300}: 0x00E0, // à
+	{0x0061, 0x0301}: 0x00E1, // á
+	{0x0061, 0x0302}: 0x00E2, // â
+	{0x0061, 0x0303}: 0x00E3, // ã
+	{0x0061, 0x0308}: 0x00E4, // ä
+	{0x0061, 0x030A}: 0x00E5, // å
+	{0x0063, 0x0327}: 0x00E7, // ç
+	{0x0065, 0x0300}: 0x00E8, // è
+	{0x0065, 0x0301}: 0x00E9, // é
+	{0x0065, 0x0302}: 0x00EA, // ê
+	{0x0065, 0x0308}: 0x00EB, // ë
+	{0x0069, 0x0300}: 0x00EC, // ì
+	{0x0069, 0x0301}: 0x00ED, // í
+	{0x0069, 0x0302}: 0x00EE, // î
+	{0x0069, 0x0308}: 0x00EF, // ï
+	{0x006E, 0x0303}: 0x00F1, // ñ
+	{0x006F, 0x0300}: 0x00F2, // ò
+	{0x006F, 0x0301}: 0x00F3, // ó
+	{0x006F, 0x0302}: 0x00F4, // ô
+	{0x006F, 0x0303}: 0x00F5, // õ
+	{0x006F, 0x0308}: 0x00F6, // ö
+	{0x0075, 0x0300}: 0x00F9, // ù
+	{0x0075, 0x0301}: 0x00FA, // ú
+	{0x0075, 0x0302}: 0x00FB, // û
+	{0x0075, 0x0308}: 0x00FC, // ü
+	{0x0079, 0x0301}: 0x00FD, // ý
+	{0x0079, 0x0308}: 0x00FF, // ÿ
+	{0x0041, 0x0304}: 0x0100, // Ā
+	{0x0061, 0x0304}: 0x0101, // ā
+	{0x0041, 0x0306}: 0x0102, // Ă
+	{0x0061, 0x0306}: 0x0103, // ă
+	{0x0041, 0x0328}: 0x0104, // Ą
+	{0x0061, 0x0328}: 0x0105, // ą
+	{0x0043, 0x0301}: 0x0106, // Ć
+	{0x0063, 0x0301}: 0x0107, // ć
+	{0x0043, 0x0302}: 0x0108, // Ĉ
+	{0x0063, 0x0302}: 0x0109, // ĉ
+	{0x0043, 0x0307}: 0x010A, // Ċ
+	{0x0063, 0x0307}: 0x010B, // ċ
+	{0x0043, 0x030C}: 0x010C, // Č
+	{0x0063, 0x030C}: 0x010D, // č
+	{0x0044, 0x030C}: 0x010E, // Ď
+	{0x0064, 0x030C}: 0x010F, // ď
+	{0x0045, 0x0304}: 0x0112, // Ē
+	{0x0065, 0x0304}: 0x0113, // ē
+	{0x0045, 0x0306}: 0x0114, // Ĕ
+	{0x0065, 0x0306}: 0x0115, // ĕ
+	{0x0045, 0x0307}: 0x0116, // Ė
+	{0x0065, 0x0307}: 0x0117, // ė
+	{0x0045, 0x0328}: 0x0118, // Ę
+	{0x0065, 0x0328}: 0x0119, // ę
+	{0x0045, 0x030C}: 0x011A, // Ě
+	{0x0065, 0x030C}: 0x011B, // ě
+	{0x0047, 0x0302}: 0x011C, // Ĝ
+	{0x0067, 0x0302}: 0x011D, // ĝ
+	{0x0047, 0x0306}: 0x011E, // Ğ
+	{0x0067, 0x0306}: 0x011F, // ğ
+	{0x0047, 0x0307}: 0x0120, // Ġ
+	{0x0067, 0x0307}: 0x0121, // ġ
+	{0x0047, 0x0327}: 0x0122, // Ģ
+	{0x0067, 0x0327}: 0x0123, // ģ
+	{0x0048, 0x0302}: 0x0124, // Ĥ
+	{0x0068, 0x0302}: 0x0125, // ĥ
+	{0x0049, 0x0303}: 0x0128, // Ĩ
+	{0x0069, 0x0303}: 0x0129, // ĩ
+	{0x0049, 0x0304}: 0x012A, // Ī
+	{0x0069, 0x0304}: 0x012B, // ī
+	{0x0049, 0x0306}: 0x012C, // Ĭ
+	{0x0069, 0x0306}: 0x012D, // ĭ
+	{0x0049, 0x0328}: 0x012E, // Į
+	{0x0069, 0x0328}: 0x012F, // į
+	{0x0049, 0x0307}: 0x0130, // İ
+	{0x004A, 0x0302}: 0x0134, // Ĵ
+	{0x006A, 0x0302}: 0x0135, // ĵ
+	{0x004B, 0x0327}: 0x0136, // Ķ
+	{0x006B, 0x0327}: 0x0137, // ķ
+	{0x004C, 0x0301}: 0x0139, // Ĺ
+	{0x006C, 0x0301}: 0x013A, // ĺ
+	{0x004C, 0x0327}: 0x013B, // Ļ
+	{0x006C, 0x0327}: 0x013C, // ļ
+	{0x004C, 0x030C}: 0x013D, // Ľ
+	{0x006C, 0x030C}: 0x013E, // ľ
+	{0x004E, 0x0301}: 0x0143, // Ń
+	{0x006E, 0x0301}: 0x0144, // ń
+	{0x004E, 0x0327}: 0x0145, // Ņ
+	{0x006E, 0x0327}: 0x0146, // ņ
+	{0x004E, 0x030C}: 0x0147, // Ň
+	{0x006E, 0x030C}: 0x0148, // ň
+	{0x004F, 0x0304}: 0x014C, // Ō
+	{0x006F, 0x0304}: 0x014D, // ō
+	{0x004F, 0x0306}: 0x014E, // Ŏ
+	{0x006F, 0x0306}: 0x014F, // ŏ
+	{0x004F, 0x030B}: 0x0150, // Ő
+	{0x006F, 0x030B}: 0x0151, // ő
+	{0x0052, 0x0301}: 0x0154, // Ŕ
+	{0x0072, 0x0301}: 0x0155, // ŕ
+	{0x0052, 0x0327}: 0x0156, // Ŗ
+	{0x0072, 0x0327}: 0x0157, // ŗ
+	{0x0052, 0x030C}: 0x0158, // Ř
+	{0x0072, 0x030C}: 0x0159, // ř
+	{0x0053, 0x0301}: 0x015A, // Ś
+	{0x0073, 0x0301}: 0x015B, // ś
+	{0x0053, 0x0302}: 0x015C, // Ŝ
+	{0x0073, 0x0302}: 0x015D, // ŝ
+	{0x0053, 0x0327}: 0x015E, // Ş
+	{0x0073, 0x0327}: 0x015F, // ş
+	{0x0053, 0x030C}: 0x0160, // Š
+	{0x0073, 0x030C}: 0x0161, // š
+	{0x0054, 0x0327}: 0x0162, // Ţ
+	{0x0074, 0x0327}: 0x0163, // ţ
+	{0x0054, 0x030C}: 0x0164, // Ť
+	{0x0074, 0x030C}: 0x0165, // ť
+	{0x0055, 0x0303}: 0x0168, // Ũ
+	{0x0075, 0x0303}: 0x0169, // ũ
+	{0x0055, 0x0304}: 0x016A, // Ū
+	{0x0075, 0x0304}: 0x016B, // ū
+	{0x0055, 0x0306}: 0x016C, // Ŭ
+	{0x0075, 0x0306}: 0x016D, // ŭ
+	{0x0055, 0x030A}: 0x016E, // Ů
+	{0x0075, 0x030A}: 0x016F, // ů
+	{0x0055, 0x030B}: 0x0170, // Ű
+	{0x0075, 0x030B}: 0x0171, // ű
+	{0x0055, 0x0328}: 0x0172, // Ų
+	{0x0075, 0x0328}: 0x0173, // ų
+	{0x0057, 0x0302}: 0x0174, // Ŵ
+	{0x0077, 0x0302}: 0x0175, // ŵ
+	{0x0059, 0x0302}: 0x0176, // Ŷ
+	{0x0079, 0x0302}: 0x0177, // ŷ
+	{0x0059, 0x0308}: 0x0178, // Ÿ
+	{0x005A, 0x0301}: 0x0179, // Ź
+	{0x007A, 0x0301}: 0x017A, // ź
+	{0x005A, 0x0307}: 0x017B, // Ż
+	{0x007A, 0x0307}: 0x017C, // ż
+	{0x005A, 0x030C}: 0x017D, // Ž
+	{0x007A, 0x030C}: 0x017E, // ž
+	{0x004F, 0x031B}: 0x01A0, // Ơ
+	{0x006F, 0x031B}: 0x01A1, // ơ
+	{0x0055, 0x031B}: 0x01AF, // Ư
+	{0x0075, 0x031B}: 0x01B0, // ư
+	{0x0041, 0x030C}: 0x01CD, // Ǎ
+	{0x0061, 0x030C}: 0x01CE, // ǎ
+	{0x0049, 0x030C}: 0x01CF, // Ǐ
+	{0x0069, 0x030C}: 0x01D0, // ǐ
+	{0x004F, 0x030C}: 0x01D1, // Ǒ
+	{0x006F, 0x030C}: 0x01D2, // ǒ
+	{0x0055, 0x030C}: 0x01D3, // Ǔ
+	{0x0075, 0x030C}: 0x01D4, // ǔ
+	{0x00DC, 0x0304}: 0x01D5, // Ǖ
+	{0x00FC, 0x0304}: 0x01D6, // ǖ
+	{0x00DC, 0x0301}: 0x01D7, // Ǘ
+	{0x00FC, 0x0301}: 0x01D8, // ǘ
+	{0x00DC, 0x030C}: 0x01D9, // Ǚ
+	{0x00FC, 0x030C}: 0x01DA, // ǚ
+	{0x00DC, 0x0300}: 0x01DB, // Ǜ
+	{0x00FC, 0x0300}: 0x01DC, // ǜ
+	{0x00C4, 0x0304}: 0x01DE, // Ǟ
+	{0x00E4, 0x0304}: 0x01DF, // ǟ
+	{0x0226, 0x0304}: 0x01E0, // Ǡ
+	{0x0227, 0x0304}: 0x01E1, // ǡ
+	{0x00C6, 0x0304}: 0x01E2, // Ǣ
+	{0x00E6, 0x0304}: 0x01E3, // ǣ
+	{0x0047, 0x030C}: 0x01E6, // Ǧ
+	{0x0067, 0x030C}: 0x01E7, // ǧ
+	{0x004B, 0x030C}: 0x01E8, // Ǩ
+	{0x006B, 0x030C}: 0x01E9, // ǩ
+	{0x004F, 0x0328}: 0x01EA, // Ǫ
+	{0x006F, 0x0328}: 0x01EB, // ǫ
+	{0x01EA, 0x0304}: 0x01EC, // Ǭ
+	{0x01EB, 0x0304}: 0x01ED, // ǭ
+	{0x01B7, 0x030C}: 0x01EE, // Ǯ
+	{0x0292, 0x030C}: 0x01EF, // ǯ
+	{0x006A, 0x030C}: 0x01F0, // ǰ
+	{0x0047, 0x0301}: 0x01F4, // Ǵ
+	{0x0067, 0x0301}: 0x01F5, // ǵ
+	{0x004E, 0x0300}: 0x01F8, // Ǹ
+	{0x006E, 0x0300}: 0x01F9, // ǹ
+	{0x00C5, 0x0301}: 0x01FA, // Ǻ
+	{0x00E5, 0x0301}: 0x01FB, // ǻ
+	{0x00C6, 0x0301}: 0x01FC, // Ǽ
+	{0x00E6, 0x0301}: 0x01FD, // ǽ
+	{0x00D8, 0x0301}: 0x01FE, // Ǿ
+	{0x00F8, 0x0301}: 0x01FF, // ǿ
+	{0x0041, 0x030F}: 0x0200, // Ȁ
+	{0x0061, 0x030F}: 0x0201, // ȁ
+	{0x0041, 0x0311}: 0x0202, // Ȃ
+	{0x0061, 0x0311}: 0x0203, // ȃ
+	{0x0045, 0x030F}: 0x0204, // Ȅ
+	{0x0065, 0x030F}: 0x0205, // ȅ
+	{0x0045, 0x0311}: 0x0206, // Ȇ
+	{0x0065, 0x0311}: 0x0207, // ȇ
+	{0x0049, 0x030F}: 0x0208, // Ȉ
+	{0x0069, 0x030F}: 0x0209, // ȉ
+	{0x0049, 0x0311}: 0x020A, // Ȋ
+	{0x0069, 0x0311}: 0x020B, // ȋ
+	{0x004F, 0x030F}: 0x020C, // Ȍ
+	{0x006F, 0x030F}: 0x020D, // ȍ
+	{0x004F, 0x0311}: 0x020E, // Ȏ
+	{0x006F, 0x0311}: 0x020F, // ȏ
+	{0x0052, 0x030F}: 0x0210, // Ȑ
+	{0x0072, 0x030F}: 0x0211, // ȑ
+	{0x0052, 0x0311}: 0x0212, // Ȓ
+	{0x0072, 0x0311}: 0x0213, // ȓ
+	{0x0055, 0x030F}: 0x0214, // Ȕ
+	{0x0075, 0x030F}: 0x0215, // ȕ
+	{0x0055, 0x0311}: 0x0216, // Ȗ
+	{0x0075, 0x0311}: 0x0217, // ȗ
+	{0x0053, 0x0326}: 0x0218, // Ș
+	{0x0073, 0x0326}: 0x0219, // ș
+	{0x0054, 0x0326}: 0x021A, // Ț
+	{0x0074, 0x0326}: 0x021B, // ț
+	{0x0048, 0x030C}: 0x021E, // Ȟ
+	{0x0068, 0x030C}: 0x021F, // ȟ
+	{0x0041, 0x0307}: 0x0226, // Ȧ
+	{0x0061, 0x0307}: 0x0227, // ȧ
+	{0x0045, 0x0327}: 0x0228, // Ȩ
+	{0x0065, 0x0327}: 0x0229, // ȩ
+	{0x00D6, 0x0304}: 0x022A, // Ȫ
+	{0x00F6, 0x0304}: 0x022B, // ȫ
+	{0x00D5, 0x0304}: 0x022C, // Ȭ
+	{0x00F5, 0x0304}: 0x022D, // ȭ
+	{0x004F, 0x0307}: 0x022E, // Ȯ
+	{0x006F, 0x0307}: 0x022F, // ȯ
+	{0x022E, 0x0304}: 0x0230, // Ȱ
+	{0x022F, 0x0304}: 0x0231, // ȱ
+	{0x0059, 0x0304}: 0x0232, // Ȳ
+	{0x0079, 0x0304}: 0x0233, // ȳ
+	{0x00A8, 0x0301}: 0x0385, // ΅
+	{0x0391, 0x0301}: 0x0386, // Ά
+	{0x0395, 0x0301}: 0x0388, // Έ
+	{0x0397, 0x0301}: 0x0389, // Ή
+	{0x0399, 0x0301}: 0x038A, // Ί
+	{0x039F, 0x0301}: 0x038C, // Ό
+	{0x03A5, 0x0301}: 0x038E, // Ύ
+	{0x03A9, 0x0301}: 0x038F, // Ώ
+	{0x03CA, 0x0301}: 0x0390, // ΐ
+	{0x0399, 0x0308}: 0x03AA, // Ϊ
+	{0x03A5, 0x0308}: 0x03AB, // Ϋ
+	{0x03B1, 0x0301}: 0x03AC, // ά
+	{0x03B5, 0x0301}: 0x03AD, // έ
+	{0x03B7, 0x0301}: 0x03AE, // ή
+	{0x03B9, 0x0301}: 0x03AF, // ί
+	{0x03CB, 0x0301}: 0x03B0, // ΰ
+	{0x03B9, 0x0308}: 0x03CA, // ϊ
+	{0x03C5, 0x0308}: 0x03CB, // ϋ
+	{0x03BF, 0x0301}: 0x03CC, // ό
+	{0x03C5, 0x0301}: 0x03CD, // ύ
+	{0x03C9, 0x0301}: 0x03CE, // ώ
+	{0x03D2, 0x0301}: 0x03D3, // ϓ
+	{0x03D2, 0x0308}: 0x03D4, // ϔ
+	{0x0415, 0x0300}: 0x0400, // Ѐ
+	{0x0415, 0x0308}: 0x0401, // Ё
+	{0x0413, 0x0301}: 0x0403, // Ѓ
+	{0x0406, 0x0308}: 0x0407, // Ї
+	{0x041A, 0x0301}: 0x040C, // Ќ
+	{0x0418, 0x0300}: 0x040D, // Ѝ
+	{0x0423, 0x0306}: 0x040E, // Ў
+	{0x0418, 0x0306}: 0x0419, // Й
+	{0x0438, 0x0306}: 0x0439, // й
+	{0x0435, 0x0300}: 0x0450, // ѐ
+	{0x0435, 0x0308}: 0x0451, // ё
+	{0x0433, 0x0301}: 0x0453, // ѓ
+	{0x0456, 0x0308}: 0x0457, // ї
+	{0x043A, 0x0301}: 0x045C, // ќ
+	{0x0438, 0x0300}: 0x045D, // ѝ
+	{0x0443, 0x0306}: 0x045E, // ў
+	{0x0474, 0x030F}: 0x0476, // Ѷ
+	{0x0475, 0x030F}: 0x0477, // ѷ
+	{0x0416, 0x0306}: 0x04C1, // Ӂ
+	{0x0436, 0x0306}: 0x04C2, // ӂ
+	{0x0410, 0x0306}: 0x04D0, // Ӑ
+	{0x0430, 0x0306}: 0x04D1, // ӑ
+	{0x0410, 0x0308}: 0x04D2, // Ӓ
+	{0x0430, 0x0308}: 0x04D3, // ӓ
+	{0x0415, 0x0306}: 0x04D6, // Ӗ
+	{0x0435, 0x0306}: 0x04D7, // ӗ
+	{0x04D8, 0x0308}: 0x04DA, // Ӛ
+	{0x04D9, 0x0308}: 0x04DB, // ӛ
+	{0x0416, 0x0308}: 0x04DC, // Ӝ
+	{0x0436, 0x0308}: 0x04DD, // ӝ
+	{0x0417, 0x0308}: 0x04DE, // Ӟ
+	{0x0437, 0x0308}: 0x04DF, // ӟ
+	{0x0418, 0x0304}: 0x04E2, // Ӣ
+	{0x0438, 0x0304}: 0x04E3, // ӣ
+	{0x0418, 0x0308}: 0x04E4, // Ӥ
+	{0x0438, 0x0308}: 0x04E5, // ӥ
+	{0x041E, 0x0308}: 0x04E6, // Ӧ
+	{0x043E, 0x0308}: 0x04E7, // ӧ
+	{0x04E8, 0x0308}: 0x04EA, // Ӫ
+	{0x04E9, 0x0308}: 0x04EB, // ӫ
+	{0x042D, 0x0308}: 0x04EC, // Ӭ
+	{0x044D, 0x0308}: 0x04ED, // ӭ
+	{0x0423, 0x0304}: 0x04EE, // Ӯ
+	{0x0443, 0x0304}: 0x04EF, // ӯ
+	{0x0423, 0x0308}: 0x04F0, // Ӱ
+	{0x0443, 0x0308}: 0x04F1, // ӱ
+	{0x0423, 0x030B}: 0x04F2, // Ӳ
+	{0x0443, 0x030B}: 0x04F3, // ӳ
+	{0x0427, 0x0308}: 0x04F4, // Ӵ
+	{0x0447, 0x0308}: 0x04F5, // ӵ
+	{0x042B, 0x0308}: 0x04F8, // Ӹ
+	{0x044B, 0x0308}: 0x04F9, // ӹ
+	{0x0041, 0x0325}: 0x1E00, // Ḁ
+	{0x0061, 0x0325}: 0x1E01, // ḁ
+	{0x0042, 0x0307}: 0x1E02, // Ḃ
+	{0x0062, 0x0307}: 0x1E03, // ḃ
+	{0x0042, 0x0323}: 0x1E04, // Ḅ
+	{0x0062, 0x0323}: 0x1E05, // ḅ
+	{0x0042, 0x0331}: 0x1E06, // Ḇ
+	{0x0062, 0x0331}: 0x1E07, // ḇ
+	{0x00C7, 0x0301}: 0x1E08, // Ḉ
+	{0x00E7, 0x0301}: 0x1E09, // ḉ
+	{0x0044, 0x0307}: 0x1E0A, // Ḋ
+	{0x0064, 0x0307}: 0x1E0B, // ḋ
+	{0x0044, 0x0323}: 0x1E0C, // Ḍ
+	{0x0064, 0x0323}: 0x1E0D, // ḍ
+	{0x0044, 0x0331}: 0x1E0E, // Ḏ
+	{0x0064, 0x0331}: 0x1E0F, // ḏ
+	{0x0044, 0x0327}: 0x1E10, // Ḑ
+	{0x0064, 0x0327}: 0x1E11, // ḑ
+	{0x0044, 0x032D}: 0x1E12, // Ḓ
+	{0x0064, 0x032D}: 0x1E13, // ḓ
+	{0x0112, 0x0300}: 0x1E14, // Ḕ
+	{0x0113, 0x0300}: 0x1E15, // ḕ
+	{0x0112, 0x0301}: 0x1E16, // Ḗ
+	{0x0113, 0x0301}: 0x1E17, // ḗ
+	{0x0045, 0x032D}: 0x1E18, // Ḙ
+	{0x0065, 0x032D}: 0x1E19, // ḙ
+	{0x0045, 0x0330}: 0x1E1A, // Ḛ
+	{0x0065, 0x0330}: 0x1E1B, // ḛ
+	{0x0228, 0x0306}: 0x1E1C, // Ḝ
+	{0x0229, 0x0306}: 0x1E1D, // ḝ
+	{0x0046, 0x0307}: 0x1E1E, // Ḟ
+	{0x0066, 0x0307}: 0x1E1F, // ḟ
+	{0x0047, 0x0304}: 0x1E20, // Ḡ
+	{0x0067, 0x0304}: 0x1E21, // ḡ
+	{0x0048, 0x0307}: 0x1E22, // Ḣ
+	{0x0068, 0x0307}: 0x1E23, // ḣ
+	{0x0048, 0x0323}: 0x1E24, // Ḥ
+	{0x0068, 0x0323}: 0x1E25, // ḥ
+	{0x0048, 0x0308}: 0x1E26, // Ḧ
+	{0x0068, 0x0308}: 0x1E27, // ḧ
+	{0x0048, 0x0327}: 0x1E28, // Ḩ
+	{0x0068, 0x0327}: 0x1E29, // ḩ
+	{0x0048, 0x032E}: 0x1E2A, // Ḫ
+	{0x0068, 0x032E}: 0x1E2B, // ḫ
+	{0x0049, 0x0330}: 0x1E2C, // Ḭ
+	{0x0069, 0x0330}: 0x1E2D, // ḭ
+	{0x00CF, 0x0301}: 0x1E2E, // Ḯ
+	{0x00EF, 0x0301}: 0x1E2F, // ḯ
+	{0x004B, 0x0301}: 0x1E30, // Ḱ
+	{0x006B, 0x0301}: 0x1E31, // ḱ
+	{0x004B, 0x0323}: 0x1E32, // Ḳ
+	{0x006B, 0x0323}: 0x1E33, // ḳ
+	{0x004B, 0x0331}: 0x1E34, // Ḵ
+	{0x006B, 0x0331}: 0x1E35, // ḵ
+	{0x004C, 0x0323}: 0x1E36, // Ḷ
+	{0x006C, 0x0323}: 0x1E37, // ḷ
+	{0x1E36, 0x0304}: 0x1E38, // Ḹ
+	{0x1E37, 0x0304}: 0x1E39, // ḹ
+	{0x004C, 0x0331}: 0x1E3A, // Ḻ
+	{0x006C, 0x0331}: 0x1E3B, // ḻ
+	{0x004C, 0x032D}: 0x1E3C, // Ḽ
+	{0x006C, 0x032D}: 0x1E3D, // ḽ
+	{0x004D, 0x0301}: 0x1E3E, // Ḿ
+	{0x006D, 0x0301}: 0x1E3F, // ḿ
+	{0x004D, 0x0307}: 0x1E40, // Ṁ
+	{0x006D, 0x0307}: 0x1E41, // ṁ
+	{0x004D, 0x0323}: 0x1E42, // Ṃ
+	{0x006D, 0x0323}: 0x1E43, // ṃ
+	{0x004E, 0x0307}: 0x1E44, // Ṅ
+	{0x006E, 0x0307}: 0x1E45, // ṅ
+	{0x004E, 0x0323}: 0x1E46, // Ṇ
+	{0x006E, 0x0323}: 0x1E47, // ṇ
+	{0x004E, 0x0331}: 0x1E48, // Ṉ
+	{0x006E, 0x0331}: 0x1E49, // ṉ
+	{0x004E, 0x032D}: 0x1E4A, // Ṋ
+	{0x006E, 0x032D}: 0x1E4B, // ṋ
+	{0x00D5, 0x0301}: 0x1E4C, // Ṍ
+	{0x00F5, 0x0301}: 0x1E4D, // ṍ
+	{0x00D5, 0x0308}: 0x1E4E, // Ṏ
+	{0x00F5, 0x0308}: 0x1E4F, // ṏ
+	{0x014C, 0x0300}: 0x1E50, // Ṑ
+	{0x014D, 0x0300}: 0x1E51, // ṑ
+	{0x014C, 0x0301}: 0x1E52, // Ṓ
+	{0x014D, 0x0301}: 0x1E53, // ṓ
+	{0x0050, 0x0301}: 0x1E54, // Ṕ
+	{0x0070, 0x0301}: 0x1E55, // ṕ
+	{0x0050, 0x0307}: 0x1E56, // Ṗ
+	{0x0070, 0x0307}: 0x1E57, // ṗ
+	{0x0052, 0x0307}: 0x1E58, // Ṙ
+	{0x0072, 0x0307}: 0x1E59, // ṙ
+	{0x0052, 0x0323}: 0x1E5A, // Ṛ
+	{0x0072, 0x0323}: 0x1E5B, // ṛ
+	{0x1E5A, 0x0304}: 0x1E5C, // Ṝ
+	{0x1E5B, 0x0304}: 0x1E5D, // ṝ
+	{0x0052, 0x0331}: 0x1E5E, // Ṟ
+	{0x0072, 0x0331}: 0x1E5F, // ṟ
+	{0x0053, 0x0307}: 0x1E60, // Ṡ
+	{0x0073, 0x0307}: 0x1E61, // ṡ
+	{0x0053, 0x0323}: 0x1E62, // Ṣ
+	{0x0073, 0x0323}: 0x1E63, // ṣ
+	{0x015A, 0x0307}: 0x1E64, // Ṥ
+	{0x015B, 0x0307}: 0x1E65, // ṥ
+	{0x0160, 0x0307}: 0x1E66, // Ṧ
+	{0x0161, 0x0307}: 0x1E67, // ṧ
+	{0x1E62, 0x0307}: 0x1E68, // Ṩ
+	{0x1E63, 0x0307}: 0x1E69, // ṩ
+	{0x0054, 0x0307}: 0x1E6A, // Ṫ
+	{0x0074, 0x0307}: 0x1E6B, // ṫ
+	{0x0054, 0x0323}: 0x1E6C, // Ṭ
+	{0x0074, 0x0323}: 0x1E6D, // ṭ
+	{0x0054, 0x0331}: 0x1E6E, // Ṯ
+	{0x0074, 0x0331}: 0x1E6F, // ṯ
+	{0x0054, 0x032D}: 0x1E70, // Ṱ
+	{0x0074, 0x032D}: 0x1E71, // ṱ
+	{0x0055, 0x0324}: 0x1E72, // Ṳ
+	{0x0075, 0x0324}: 0x1E73, // ṳ
+	{0x0055, 0x0330}: 0x1E74, // Ṵ
+	{0x0075, 0x0330}: 0x1E75, // ṵ
+	{0x0055, 0x032D}: 0x1E76, // Ṷ
+	{0x0075, 0x032D}: 0x1E77, // ṷ
+	{0x0168, 0x0301}: 0x1E78, // Ṹ
+	{0x0169, 0x0301}: 0x1E79, // ṹ
+	{0x016A, 0x0308}: 0x1E7A, // Ṻ
+	{0x016B, 0x0308}: 0x1E7B, // ṻ
+	{0x0056, 0x0303}: 0x1E7C, // Ṽ
+	{0x0076, 0x0303}: 0x1E7D, // ṽ
+	{0x0056, 0x0323}: 0x1E7E, // Ṿ
+	{0x0076, 0x0323}: 0x1E7F, // ṿ
+	{0x0057, 0x0300}: 0x1E80, // Ẁ
+	{0x0077, 0x0300}: 0x1E81, // ẁ
+	{0x0057, 0x0301}: 0x1E82, // Ẃ
+	{0x0077, 0x0301}: 0x1E83, // ẃ
+	{0x0057, 0x0308}: 0x1E84, // Ẅ
+	{0x0077, 0x0308}: 0x1E85, // ẅ
+	{0x0057, 0x0307}: 0x1E86, // Ẇ
+	{0x0077, 0x0307}: 0x1E87, // ẇ
+	{0x0057, 0x0323}: 0x1E88, // Ẉ
+	{0x0077, 0x0323}: 0x1E89, // ẉ
+	{0x0058, 0x0307}: 0x1E8A, // Ẋ
+	{0x0078, 0x0307}: 0x1E8B, // ẋ
+	{0x0058, 0x0308}: 0x1E8C, // Ẍ
+	{0x0078, 0x0308}: 0x1E8D, // ẍ
+	{0x0059, 0x0307}: 0x1E8E, // Ẏ
+	{0x0079, 0x0307}: 0x1E8F, // ẏ
+	{0x005A, 0x0302}: 0x1E90, // Ẑ
+	{0x007A, 0x0302}: 0x1E91, // ẑ
+	{0x005A, 0x0323}: 0x1E92, // Ẓ
+	{0x007A, 0x0323}: 0x1E93, // ẓ
+	{0x005A, 0x0331}: 0x1E94, // Ẕ
+	{0x007A, 0x0331}: 0x1E95, // ẕ
+	{0x0068, 0x0331}: 0x1E96, // ẖ
+	{0x0074, 0x0308}: 0x1E97, // ẗ
+	{0x0077, 0x030A}: 0x1E98, // ẘ
+	{0x0079, 0x030A}: 0x1E99, // ẙ
+	{0x017F, 0x0307}: 0x1E9B, // ẛ
+	{0x0041, 0x0323}: 0x1EA0, // Ạ
+	{0x0061, 0x0323}: 0x1EA1, // ạ
+	{0x0041, 0x0309}: 0x1EA2, // Ả
+	{0x0061, 0x0309}: 0x1EA3, // ả
+	{0x00C2, 0x0301}: 0x1EA4, // Ấ
+	{0x00E2, 0x0301}: 0x1EA5, // ấ
+	{0x00C2, 0x0300}: 0x1EA6, // Ầ
+	{0x00E2, 0x0300}: 0x1EA7, // ầ
+	{0x00C2, 0x0309}: 0x1EA8, // Ẩ
+	{0x00E2, 0x0309}: 0x1EA9, // ẩ
+	{0x00C2, 0x0303}: 0x1EAA, // Ẫ
+	{0x00E2, 0x0303}: 0x1EAB, // ẫ
+	{0x1EA0, 0x0302}: 0x1EAC, // Ậ
+	{0x1EA1, 0x0302}: 0x1EAD, // ậ
+	{0x0102, 0x0301}: 0x1EAE, // Ắ
+	{0x0103, 0x0301}: 0x1EAF, // ắ
+	{0x0102, 0x0300}: 0x1EB0, // Ằ
+	{0x0103, 0x0300}: 0x1EB1, // ằ
+	{0x0102, 0x0309}: 0x1EB2, // Ẳ
+	{0x0103, 0x0309}: 0x1EB3, // ẳ
+	{0x0102, 0x0303}: 0x1EB4, // Ẵ
+	{0x0103, 0x0303}: 0x1EB5, // ẵ
+	{0x1EA0, 0x0306}: 0x1EB6, // Ặ
+	{0x1EA1, 0x0306}: 0x1EB7, // ặ
+	{0x0045, 0x0323}: 0x1EB8, // Ẹ
+	{0x0065, 0x0323}: 0x1EB9, // ẹ
+	{0x0045, 0x0309}: 0x1EBA, // Ẻ
+	{0x0065, 0x0309}: 0x1EBB, // ẻ
+	{0x0045, 0x0303}: 0x1EBC, // Ẽ
+	{0x0065, 0x0303}: 0x1EBD, // ẽ
+	{0x00CA, 0x0301}: 0x1EBE, // Ế
+	{0x00EA, 0x0301}: 0x1EBF, // ế
+	{0x00CA, 0x0300}: 0x1EC0, // Ề
+	{0x00EA, 0x0300}: 0x1EC1, // ề
+	{0x00CA, 0x0309}: 0x1EC2, // Ể
+	{0x00EA, 0x0309}: 0x1EC3, // ể
+	{0x00CA, 0x0303}: 0x1EC4, // Ễ
+	{0x00EA, 0x0303}: 0x1EC5, // ễ
+	{0x1EB8, 0x0302}: 0x1EC6, // Ệ
+	{0x1EB9, 0x0302}: 0x1EC7, // ệ
+	{0x0049, 0x0309}: 0x1EC8, // Ỉ
+	{0x0069, 0x0309}: 0x1EC9, // ỉ
+	{0x0049, 0x0323}: 0x1ECA, // Ị
+	{0x0069, 0x0323}: 0x1ECB, // ị
+	{0x004F, 0x0323}: 0x1ECC, // Ọ
+	{0x006F, 0x0323}: 0x1ECD, // ọ
+	{0x004F, 0x0309}: 0x1ECE, // Ỏ
+	{0x006F, 0x0309}: 0x1ECF, // ỏ
+	{0x00D4, 0x0301}: 0x1ED0, // Ố
+	{0x00F4, 0x0301}: 0x1ED1, // ố
+	{0x00D4, 0x0300}: 0x1ED2, // Ồ
+	{0x00F4, 0x0300}: 0x1ED3, // ồ
+	{0x00D4, 0x0309}: 0x1ED4, // Ổ
+	{0x00F4, 0x0309}: 0x1ED5, // ổ
+	{0x00D4, 0x0303}: 0x1ED6, // Ỗ
+	{0x00F4, 0x0303}: 0x1ED7, // ỗ
+	{0x1ECC, 0x0302}: 0x1ED8, // Ộ
+	{0x1ECD, 0x0302}: 0x1ED9, // ộ
+	{0x01A0, 0x0301}: 0x1EDA, // Ớ
+	{0x01A1, 0x0301}: 0x1EDB, // ớ
+	{0x01A0, 0x0300}: 0x1EDC, // Ờ
+	{0x01A1, 0x0300}: 0x1EDD, // ờ
+	{0x01A0, 0x0309}: 0x1EDE, // Ở
+	{0x01A1, 0x0309}: 0x1EDF, // ở
+	{0x01A0, 0x0303}: 0x1EE0, // Ỡ
+	{0x01A1, 0x0303}: 0x1EE1, // ỡ
+	{0x01A0, 0x0323}: 0x1EE2, // Ợ
+	{0x01A1, 0x0323}: 0x1EE3, // ợ
+	{0x0055, 0x0323}: 0x1EE4, // Ụ
+	{0x0075, 0x0323}: 0x1EE5, // ụ
+	{0x0055, 0x0309}: 0x1EE6, // Ủ
+	{0x0075, 0x0309}: 0x1EE7, // ủ
+	{0x01AF, 0x0301}: 0x1EE8, // Ứ
+	{0x01B0, 0x0301}: 0x1EE9, // ứ
+	{0x01AF, 0x0300}: 0x1EEA, // Ừ
+	{0x01B0, 0x0300}: 0x1EEB, // ừ
+	{0x01AF, 0x0309}: 0x1EEC, // Ử
+	{0x01B0, 0x0309}: 0x1EED, // ử
+	{0x01AF, 0x0303}: 0x1EEE, // Ữ
+	{0x01B0, 0x0303}: 0x1EEF, // ữ
+	{0x01AF, 0x0323}: 0x1EF0, // Ự
+	{0x01B0, 0x0323}: 0x1EF1, // ự
+	{0x0059, 0x0300}: 0x1EF2, // Ỳ
+	{0x0079, 0x0300}: 0x1EF3, // ỳ
+	{0x0059, 0x0323}: 0x1EF4, // Ỵ
+	{0x0079, 0x0323}: 0x1EF5, // ỵ
+	{0x0059, 0x0309}: 0x1EF6, // Ỷ
+	{0x0079, 0x0309}: 0x1EF7, // ỷ
+	{0x0059, 0x0303}: 0x1EF8, // Ỹ
+	{0x0079, 0x0303}: 0x1EF9, // ỹ
+	{0x03B1, 0x0313}: 0x1F00, // ἀ
+	{0x03B1, 0x0314}: 0x1F01, // ἁ
+	{0x1F00, 0x0300}: 0x1F02, // ἂ
+	{0x1F01, 0x0300}: 0x1F03, // ἃ
+	{0x1F00, 0x0301}: 0x1F04, // ἄ
+	{0x1F01, 0x0301}: 0x1F05, // ἅ
+	{0x1F00, 0x0342}: 0x1F06, // ἆ
+	{0x1F01, 0x0342}: 0x1F07, // ἇ
+	{0x0391, 0x0313}: 0x1F08, // Ἀ
+	{0x0391, 0x0314}: 0x1F09, // Ἁ
+	{0x1F08, 0x0300}: 0x1F0A, // Ἂ
+	{0x1F09, 0x0300}: 0x1F0B, // Ἃ
+	{0x1F08, 0x0301}: 0x1F0C, // Ἄ
+	{0x1F09, 0x0301}: 0x1F0D, // Ἅ
+	{0x1F08, 0x0342}: 0x1F0E, // Ἆ
+	{0x1F09, 0x0342}: 0x1F0F, // Ἇ
+	{0x03B5, 0x0313}: 0x1F10, // ἐ
+	{0x03B5, 0x0314}: 0x1F11, // ἑ
+	{0x1F10, 0x0300}: 0x1F12, // ἒ
+	{0x1F11, 0x0300}: 0x1F13, // ἓ
+	{0x1F10, 0x0301}: 0x1F14, // ἔ
+	{0x1F11, 0x0301}: 0x1F15, // ἕ
+	{0x0395, 0x0313}: 0x1F18, // Ἐ
+	{0x0395, 0x0314}: 0x1F19, // Ἑ
+	{0x1F18, 0x0300}: 0x1F1A, // Ἒ
+	{0x1F19, 0x0300}: 0x1F1B, // Ἓ
+	{0x1F18, 0x0301}: 0x1F1C, // Ἔ
+	{0x1F19, 0x0301}: 0x1F1D, // Ἕ
+	{0x03B7, 0x0313}: 0x1F20, // ἠ
+	{0x03B7, 0x0314}: 0x1F21, // ἡ
+	{0x1F20, 0x0300}: 0x1F22, // ἢ
+	{0x1F21, 0x0300}: 0x1F23, // ἣ
+	{0x1F20, 0x0301}: 0x1F24, // ἤ
+	{0x1F21, 0x0301}: 0x1F25, // ἥ
+	{0x1F20, 0x0342}: 0x1F26, // ἦ
+	{0x1F21, 0x0342}: 0x1F27, // ἧ
+	{0x0397, 0x0313}: 0x1F28, // Ἠ
+	{0x0397, 0x0314}: 0x1F29, // Ἡ
+	{0x1F28, 0x0300}: 0x1F2A, // Ἢ
+	{0x1F29, 0x0300}: 0x1F2B, // Ἣ
+	{0x1F28, 0x0301}: 0x1F2C, // Ἤ
+	{0x1F29, 0x0301}: 0x1F2D, // Ἥ
+	{0x1F28, 0x0342}: 0x1F2E, // Ἦ
+	{0x1F29, 0x0342}: 0x1F2F, // Ἧ
+	{0x03B9, 0x0313}: 0x1F30, // ἰ
+	{0x03B9, 0x0314}: 0x1F31, // ἱ
+	{0x1F30, 0x0300}: 0x1F32, // ἲ
+	{0x1F31, 0x0300}: 0x1F33, // ἳ
+	{0x1F30, 0x0301}: 0x1F34, // ἴ
+	{0x1F31, 0x0301}: 0x1F35, // ἵ
+	{0x1F30, 0x0342}: 0x1F36, // ἶ
+	{0x1F31, 0x0342}: 0x1F37, // ἷ
+	{0x0399, 0x0313}: 0x1F38, // Ἰ
+	{0x0399, 0x0314}: 0x1F39, // Ἱ
+	{0x1F38, 0x0300}: 0x1F3A, // Ἲ
+	{0x1F39, 0x0300}: 0x1F3B, // Ἳ
+	{0x1F38, 0x0301}: 0x1F3C, // Ἴ
+	{0x1F39, 0x0301}: 0x1F3D, // Ἵ
+	{0x1F38, 0x0342}: 0x1F3E, // Ἶ
+	{0x1F39, 0x0342}: 0x1F3F, // Ἷ
+	{0x03BF, 0x0313}: 0x1F40, // ὀ
+	{0x03BF, 0x0314}: 0x1F41, // ὁ
+	{0x1F40, 0x0300}: 0x1F42, // ὂ
+	{0x1F41, 0x0300}: 0x1F43, // ὃ
+	{0x1F40, 0x0301}: 0x1F44, // ὄ
+	{0x1F41, 0x0301}: 0x1F45, // ὅ
+	{0x039F, 0x0313}: 0x1F48, // Ὀ
+	{0x039F, 0x0314}: 0x1F49, // Ὁ
+	{0x1F48, 0x0300}: 0x1F4A, // Ὂ
+	{0x1F49, 0x0300}: 0x1F4B, // Ὃ
+	{0x1F48, 0x0301}: 0x1F4C, // Ὄ
+	{0x1F49, 0x0301}: 0x1F4D, // Ὅ
+	{0x03C5, 0x0313}: 0x1F50, // ὐ
+	{0x03C5, 0x0314}: 0x1F51, // ὑ
+	{0x1F50, 0x0300}: 0x1F52, // ὒ
+	{0x1F51, 0x0300}: 0x1F53, // ὓ
+	{0x1F50, 0x0301}: 0x1F54, // ὔ
+	{0x1F51, 0x0301}: 0x1F55, // ὕ
+	{0x1F50, 0x0342}: 0x1F56, // ὖ
+	{0x1F51, 0x0342}: 0x1F57, // ὗ
+	{0x03A5, 0x0314}: 0x1F59, // Ὑ
+	{0x1F59, 0x0300}: 0x1F5B, // Ὓ
+	{0x1F59, 0x0301}: 0x1F5D, // Ὕ
+	{0x1F59, 0x0342}: 0x1F5F, // Ὗ
+	{0x03C9, 0x0313}: 0x1F60, // ὠ
+	{0x03C9, 0x0314}: 0x1F61, // ὡ
+	{0x1F60, 0x0300}: 0x1F62, // ὢ
+	{0x1F61, 0x0300}: 0x1F63, // ὣ
+	{0x1F60, 0x0301}: 0x1F64, // ὤ
+	{0x1F61, 0x0301}: 0x1F65, // ὥ
+	{0x1F60, 0x0342}: 0x1F66, // ὦ
+	{0x1F61, 0x0342}: 0x1F67, // ὧ
+	{0x03A9, 0x0313}: 0x1F68, // Ὠ
+	{0x03A9, 0x0314}: 0x1F69, // Ὡ
+	{0x1F68, 0x0300}: 0x1F6A, // Ὢ
+	{0x1F69, 0x0300}: 0x1F6B, // Ὣ
+	{0x1F68, 0x0301}: 0x1F6C, // Ὤ
+	{0x1F69, 0x0301}: 0x1F6D, // Ὥ
+	{0x1F68, 0x0342}: 0x1F6E, // Ὦ
+	{0x1F69, 0x0342}: 0x1F6F, // Ὧ
+	{0x03B1, 0x0300}: 0x1F70, // ὰ
+	{0x03B5, 0x0300}: 0x1F72, // ὲ
+	{0x03B7, 0x0300}: 0x1F74, // ὴ
+	{0x03B9, 0x0300}: 0x1F76, // ὶ
+	{0x03BF, 0x0300}: 0x1F78, // ὸ
+	{0x03C5, 0x0300}: 0x1F7A, // ὺ
+	{0x03C9, 0x0300}: 0x1F7C, // ὼ
+	{0x1F00, 0x0345}: 0x1F80, // ᾀ
+	{0x1F01, 0x0345}: 0x1F81, // ᾁ
+	{0x1F02, 0x0345}: 0x1F82, // ᾂ
+	{0x1F03, 0x0345}: 0x1F83, // ᾃ
+	{0x1F04, 0x0345}: 0x1F84, // ᾄ
+	{0x1F05, 0x0345}: 0x1F85, // ᾅ
+	{0x1F06, 0x0345}: 0x1F86, // ᾆ
+	{0x1F07, 0x0345}: 0x1F87, // ᾇ
+	{0x1F08, 0x0345}: 0x1F88, // ᾈ
+	{0x1F09, 0x0345}: 0x1F89, // ᾉ
+	{0x1F0A, 0x0345}: 0x1F8A, // ᾊ
+	{0x1F0B, 0x0345}: 0x1F8B, // ᾋ
+	{0x1F0C, 0x0345}: 0x1F8C, // ᾌ
+	{0x1F0D, 0x0345}: 0x1F8D, // ᾍ
+	{0x1F0E, 0x0345}: 0x1F8E, // ᾎ
+	{0x1F0F, 0x0345}: 0x1F8F, // ᾏ
+	{0x1F20, 0x0345}: 0x1F90, // ᾐ
+	{0x1F21, 0x0345}: 0x1F91, // ᾑ
+	{0x1F22, 0x0345}: 0x1F92, // ᾒ
+	{0x1F23, 0x0345}: 0x1F93, // ᾓ
+	{0x1F24, 0x0345}: 0x1F94, // ᾔ
+	{0x1F25, 0x0345}: 0x1F95, // ᾕ
+	{0x1F26, 0x0345}: 0x1F96, // ᾖ
+	{0x1F27, 0x0345}: 0x1F97, // ᾗ
+	{0x1F28, 0x0345}: 0x1F98, // ᾘ
+	{0x1F29, 0x0345}: 0x1F99, // ᾙ
+	{0x1F2A, 0x0345}: 0x1F9A, // ᾚ
+	{0x1F2B, 0x0345}: 0x1F9B, // ᾛ
+	{0x1F2C, 0x0345}: 0x1F9C, // ᾜ
+	{0x1F2D, 0x0345}: 0x1F9D, // ᾝ
+	{0x1F2E, 0x0345}: 0x1F9E, // ᾞ
+	{0x1F2F, 0x0345}: 0x1F9F, // ᾟ
+	{0x1F60, 0x0345}: 0x1FA0, // ᾠ
+	{0x1F61, 0x0345}: 0x1FA1, // ᾡ
+	{0x1F62, 0x0345}: 0x1FA2, // ᾢ
+	{0x1F63, 0x0345}: 0x1FA3, // ᾣ
+	{0x1F64, 0x0345}: 0x1FA4, // ᾤ
+	{0x1F65, 0x0345}: 0x1FA5, // ᾥ
+	{0x1F66, 0x0345}: 0x1FA6, // ᾦ
+	{0x1F67, 0x0345}: 0x1FA7, // ᾧ
+	{0x1F68, 0x0345}: 0x1FA8, // ᾨ
+	{0x1F69, 0x0345}: 0x1FA9, // ᾩ
+	{0x1F6A, 0x0345}: 0x1FAA, // ᾪ
+	{0x1F6B, 0x0345}: 0x1FAB, // ᾫ
+	{0x1F6C, 0x0345}: 0x1FAC, // ᾬ
+	{0x1F6D, 0x0345}: 0x1FAD, // ᾭ
+	{0x1F6E, 0x0345}: 0x1FAE, // ᾮ
+	{0x1F6F, 0x0345}: 0x1FAF, // ᾯ
+	{0x03B1, 0x0306}: 0x1FB0, // ᾰ
+	{0x03B1, 0x0304}: 0x1FB1, // ᾱ
+	{0x1F70, 0x0345}: 0x1FB2, // ᾲ
+	{0x03B1, 0x0345}: 0x1FB3, // ᾳ
+	{0x03AC, 0x0345}: 0x1FB4, // ᾴ
+	{0x03B1, 0x0342}: 0x1FB6, // ᾶ
+	{0x1FB6, 0x0345}: 0x1FB7, // ᾷ
+	{0x0391, 0x0306}: 0x1FB8, // Ᾰ
+	{0x0391, 0x0304}: 0x1FB9, // Ᾱ
+	{0x0391, 0x0300}: 0x1FBA, // Ὰ
+	{0x0391, 0x0345}: 0x1FBC, // ᾼ
+	{0x00A8, 0x0342}: 0x1FC1, // ῁
+	{0x1F74, 0x0345}: 0x1FC2, // ῂ
+	{0x03B7, 0x0345}: 0x1FC3, // ῃ
+	{0x03AE, 0x0345}: 0x1FC4, // ῄ
+	{0x03B7, 0x0342}: 0x1FC6, // ῆ
+	{0x1FC6, 0x0345}: 0x1FC7, // ῇ
+	{0x0395, 0x0300}: 0x1FC8, // Ὲ
+	{0x0397, 0x0300}: 0x1FCA, // Ὴ
+	{0x0397, 0x0345}: 0x1FCC, // ῌ
+	{0x1FBF, 0x0300}: 0x1FCD, // ῍
+	{0x1FBF, 0x0301}: 0x1FCE, // ῎
+	{0x1FBF, 0x0342}: 0x1FCF, // ῏
+	{0x03B9, 0x0306}: 0x1FD0, // ῐ
+	{0x03B9, 0x0304}: 0x1FD1, // ῑ
+	{0x03CA, 0x0300}: 0x1FD2, // ῒ
+	{0x03B9, 0x0342}: 0x1FD6, // ῖ
+	{0x03CA, 0x0342}: 0x1FD7, // ῗ
+	{0x0399, 0x0306}: 0x1FD8, // Ῐ
+	{0x0399, 0x0304}: 0x1FD9, // Ῑ
+	{0x0399, 0x0300}: 0x1FDA, // Ὶ
+	{0x1FFE, 0x0300}: 0x1FDD, // ῝
+	{0x1FFE, 0x0301}: 0x1FDE, // ῞
+	{0x1FFE, 0x0342}: 0x1FDF, // ῟
+	{0x03C5, 0x0306}: 0x1FE0, // ῠ
+	{0x03C5, 0x0304}: 0x1FE1, // ῡ
+	{0x03CB, 0x0300}: 0x1FE2, // ῢ
+	{0x03C1, 0x0313}: 0x1FE4, // ῤ
+	{0x03C1, 0x0314}: 0x1FE5, // ῥ
+	{0x03C5, 0x0342}: 0x1FE6, // ῦ
+	{0x03CB, 0x0342}: 0x1FE7, // ῧ
+	{0x03A5, 0x0306}: 0x1FE8, // Ῠ
+	{0x03A5, 0x0304}: 0x1FE9, // Ῡ
+	{0x03A5, 0x0300}: 0x1FEA, // Ὺ
+	{0x03A1, 0x0314}: 0x1FEC, // Ῥ
+	{0x00A8, 0x0300}: 0x1FED, // ῭
+	{0x1F7C, 0x0345}: 0x1FF2, // ῲ
+	{0x03C9, 0x0345}: 0x1FF3, // ῳ
+	{0x03CE, 0x0345}: 0x1FF4, // ῴ
+	{0x03C9, 0x0342}: 0x1FF6, // ῶ
+	{0x1FF6, 0x0345}: 0x1FF7, // ῷ
+	{0x039F, 0x0300}: 0x1FF8, // Ὸ
+	{0x03A9, 0x0300}: 0x1FFA, // Ὼ
+	{0x03A9, 0x0345}: 0x1FFC, // ῼ
+}
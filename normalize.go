@@ -0,0 +1,26 @@
+package masker
+
+import (
+	"unicode"
+)
+
+// nfc compose the base letters and the combining marks following them into the precomposed letters,
+// a simplified canonical composition (NFC) limited to the compositions table
+func nfc(s string) string {
+	r := []rune(s)
+	ans := make([]rune, 0, len(r))
+	starter := -1
+	for _, c := range r {
+		if starter >= 0 && starter == len(ans)-1 && unicode.Is(unicode.Mn, c) {
+			if composed, ok := compositions[[2]rune{ans[starter], c}]; ok {
+				ans[starter] = composed
+				continue
+			}
+		}
+		if !unicode.Is(unicode.Mn, c) {
+			starter = len(ans)
+		}
+		ans = append(ans, c)
+	}
+	return string(ans)
+}
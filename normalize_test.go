@@ -0,0 +1,66 @@
+package masker
+
+import (
+	"testing"
+)
+
+func Test_nfc(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Empty Input",
+			args: args{
+				s: "",
+			},
+			want: "",
+		},
+		{
+			name: "Already Composed",
+			args: args{
+				s: "José",
+			},
+			want: "José",
+		},
+		{
+			name: "Decomposed",
+			args: args{
+				s: "Jose\u0301",
+			},
+			want: "José",
+		},
+		{
+			name: "Two Marks",
+			args: args{
+				s: "e\u0323\u0302",
+			},
+			want: "\u1EC7",
+		},
+		{
+			name: "Leading Mark",
+			args: args{
+				s: "\u0301e",
+			},
+			want: "\u0301e",
+		},
+		{
+			name: "No Composition",
+			args: args{
+				s: "q\u0301",
+			},
+			want: "q\u0301",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nfc(tt.args.s); got != tt.want {
+				t.Errorf("nfc() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package masker
+
+import (
+	"reflect"
+	"sync"
+)
+
+// WithParallel make Struct() mask the fields of the top-level struct with up to n goroutines,
+// each one masking a contiguous chunk of the fields. The goroutines are started on every call,
+// so it's slower than the serial masking on a single core or for the small structs,
+// compare BenchmarkStruct_Serial with BenchmarkStruct_Parallel on the target machine before turning it on.
+// The type maskers registered by RegisterTypeMasker must be safe for the concurrent use.
+//
+// Example:
+//
+//	m := masker.New(masker.WithParallel(runtime.NumCPU()))
+func WithParallel(n int) Option {
+	return func(m *Masker) {
+		m.parallel = n
+	}
+}
+
+// fieldsParallel mask the fields of src into dst with the goroutines of the WithParallel option,
+// each goroutine sets a contiguous chunk of the fields, and the error of the first field in order is returned
+func (m *Masker) fieldsParallel(dst, src reflect.Value, st *state) error {
+	plan := planOf(src.Type())
+	rules := m.structRules[src.Type()]
+	n := len(plan)
+	if n == 0 {
+		return nil
+	}
+	errs := make([]error, n)
+
+	workers := m.parallel
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	size := (n + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += size {
+		end := start + size
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				f := ruled(&plan[i], rules)
+				errs[i] = m.structField(dst, src, f, st.field("", f.Name), st)
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return wrapField(plan[i].Name, err)
+		}
+	}
+	return nil
+}
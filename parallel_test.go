@@ -0,0 +1,126 @@
+package masker
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// wideStruct build a pointer to a struct of n tagged fields of each kind, filled with the sample values
+func wideStruct(n int) interface{} {
+	type Contact struct {
+		Email  string `mask:"email"`
+		Mobile string `mask:"mobile"`
+	}
+	kinds := []struct {
+		tag string
+		val interface{}
+	}{
+		{`mask:"name"`, "ggwhite"},
+		{`mask:"email"`, "ggw.chang@gmail.com"},
+		{`mask:"mobile"`, []string{"0987654321", "0912345678"}},
+		{`mask:"struct"`, &Contact{Email: "qq@gmail.com", Mobile: "0978978978"}},
+		{`mask:"struct"`, []Contact{{Email: "ggw.chang@gmail.com"}, {Mobile: "0987654321"}}},
+		{``, "untagged"},
+	}
+
+	fields := []reflect.StructField{}
+	for i := 0; i < n; i++ {
+		for k, kind := range kinds {
+			fields = append(fields, reflect.StructField{
+				Name: fmt.Sprintf("F%d_%d", i, k),
+				Type: reflect.TypeOf(kind.val),
+				Tag:  reflect.StructTag(kind.tag),
+			})
+		}
+	}
+	v := reflect.New(reflect.StructOf(fields))
+	for i := 0; i < v.Elem().NumField(); i++ {
+		v.Elem().Field(i).Set(reflect.ValueOf(kinds[i%len(kinds)].val))
+	}
+	return v.Interface()
+}
+
+func TestWithParallel(t *testing.T) {
+	type Bad struct {
+		Name string `mask:"name"`
+		ID   string `mask:"id" masklen:"x"`
+	}
+	type args struct {
+		s interface{}
+	}
+	tests := []struct {
+		name    string
+		m       *Masker
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Wide Struct",
+			m:    New(WithParallel(4)),
+			args: args{
+				s: wideStruct(32),
+			},
+			wantErr: false,
+		},
+		{
+			name: "More Workers Than Fields",
+			m:    New(WithParallel(64)),
+			args: args{
+				s: wideStruct(1),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Only Unexported Fields",
+			m:    New(WithParallel(4)),
+			args: args{
+				s: &struct{ x int }{x: 1},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error",
+			m:    New(WithParallel(4)),
+			args: args{
+				s: &Bad{Name: "ggwhite", ID: "A123456789"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Struct(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Masker.Struct() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			want, _ := New().Struct(tt.args.s)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Masker.Struct() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func BenchmarkStruct_Serial(b *testing.B) {
+	m := New()
+	s := wideStruct(64)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.Struct(s); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkStruct_Parallel(b *testing.B) {
+	m := New(WithParallel(4))
+	s := wideStruct(64)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.Struct(s); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package masker
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+)
+
+// Detector find sensitive information of the mask type in free text
+type Detector struct {
+	Type    mtype
+	Pattern *regexp.Regexp
+}
+
+// Default detectors of Redact()
+var (
+	EmailDetector     = Detector{Type: MEmail, Pattern: regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)}
+	MobileDetector    = Detector{Type: MMobile, Pattern: regexp.MustCompile(`\b09\d{8}\b`)}
+	TelephoneDetector = Detector{Type: MTelephone, Pattern: regexp.MustCompile(`\(?\b0\d\)?[ \-]?\d{4}-?\d{4}\b`)}
+)
+
+// DefaultDetectors is used by Redact() when no detector given
+var DefaultDetectors = []Detector{EmailDetector, MobileDetector, TelephoneDetector}
+
+// WithRedactLabels make Redact() replace the matches of a mask type with a fixed label instead of masking it
+//
+// Example:
+//
+//   m := masker.New(masker.WithRedactLabels(map[string]string{
+//       masker.MEmail:  "[EMAIL REDACTED]",
+//       masker.MMobile: "[PHONE]",
+//   }))
+func WithRedactLabels(labels map[string]string) Option {
+	return func(m *Masker) {
+		m.redactLabels = labels
+	}
+}
+
+// Redact find sensitive information in free text with the detectors, and mask each match with the mask type of the detector
+//
+// Example:
+//   input: contact ggw.chang@gmail.com or 0987654321
+//   output: contact ggw****ng@gmail.com or 0987***321
+func (m *Masker) Redact(s string, detectors ...Detector) string {
+	if len(s) == 0 {
+		return ""
+	}
+	if len(detectors) == 0 {
+		detectors = DefaultDetectors
+	}
+	for _, d := range detectors {
+		s = d.Pattern.ReplaceAllStringFunc(s, func(match string) string {
+			if label, ok := m.redactLabels[string(d.Type)]; ok {
+				return label
+			}
+			return m.String(d.Type, match)
+		})
+	}
+	return s
+}
+
+// cardNumberPattern match a run of 13 to 19 digits, which may be grouped by spaces or "-"
+var cardNumberPattern = regexp.MustCompile(`\b\d(?:[ \-]?\d){12,18}\b`)
+
+// CreditCardsInText find the card numbers of 13 to 19 digits in free text, and mask each one keeping the first 6
+// and the last 4 digits, the spaces and "-" between the digits and the other text are kept.
+// With the WithLuhnCheck option only the numbers with a valid Luhn checksum are masked.
+//
+// Example:
+//
+//	input: paid by 4111 1111 1111 1111, thanks
+//	output: paid by 4111 11** **** 1111, thanks
+func (m *Masker) CreditCardsInText(s string) string {
+	return cardNumberPattern.ReplaceAllStringFunc(s, func(match string) string {
+		r := []rune(match)
+		digits := []int{}
+		for idx, c := range r {
+			if c >= '0' && c <= '9' {
+				digits = append(digits, idx)
+			}
+		}
+		if m.luhnCheck && !validLuhn(r, digits) {
+			return match
+		}
+		for _, idx := range digits[6 : len(digits)-4] {
+			r[idx] = '*'
+		}
+		return m.renderMask(string(r))
+	})
+}
+
+// validLuhn check the Luhn checksum of the digits at the indexes of r
+func validLuhn(r []rune, digits []int) bool {
+	sum := 0
+	for k := range digits {
+		d := int(r[digits[len(digits)-1-k]] - '0')
+		if k%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// WithLuhnCheck make CreditCardsInText() mask only the card numbers with a valid Luhn checksum,
+// so the other long numbers like order numbers are kept
+func WithLuhnCheck() Option {
+	return func(m *Masker) {
+		m.luhnCheck = true
+	}
+}
+
+// maskReaderWindow is how many bytes at the end of the buffer MaskReader hold back, waiting for more data
+// to decide whether they are part of a match
+const maskReaderWindow = 256
+
+type maskReader struct {
+	m         *Masker
+	r         io.Reader
+	detectors []Detector
+	buf       []byte
+	in        []byte
+	out       []byte
+	err       error
+}
+
+// NewMaskReader return a reader which Redact() the data read from r with the detectors,
+// a match split across reads of r is still masked.
+func (m *Masker) NewMaskReader(r io.Reader, detectors ...Detector) io.Reader {
+	if len(detectors) == 0 {
+		detectors = DefaultDetectors
+	}
+	return &maskReader{
+		m:         m,
+		r:         r,
+		detectors: detectors,
+		buf:       make([]byte, 4096),
+	}
+}
+
+func (mr *maskReader) Read(p []byte) (int, error) {
+	for len(mr.out) == 0 {
+		if mr.err != nil {
+			return 0, mr.err
+		}
+		n, err := mr.r.Read(mr.buf)
+		mr.in = append(mr.in, mr.buf[:n]...)
+		if err != nil {
+			mr.err = err
+			mr.flush(len(mr.in))
+			continue
+		}
+		mr.flush(mr.cut())
+	}
+	n := copy(p, mr.out)
+	mr.out = mr.out[n:]
+	return n, nil
+}
+
+// cut find the position before which the pending data can be masked without waiting for more data
+func (mr *maskReader) cut() int {
+	cut := len(mr.in) - maskReaderWindow
+	if cut <= 0 {
+		return 0
+	}
+	cut = mr.beforeMatch(cut)
+	if idx := bytes.LastIndexAny(mr.in[:cut], " \t\r\n"); idx >= 0 {
+		// a match can have a space, like "(02) 2799-3078", so it's checked again
+		cut = mr.beforeMatch(idx + 1)
+	}
+	return cut
+}
+
+// beforeMatch move the cut to the start of the match which spans it, if any
+func (mr *maskReader) beforeMatch(cut int) int {
+	for _, d := range mr.detectors {
+		for _, loc := range d.Pattern.FindAllIndex(mr.in, -1) {
+			if loc[0] < cut && loc[1] >= cut {
+				cut = loc[0]
+			}
+		}
+	}
+	return cut
+}
+
+// flush mask the first n bytes of the pending data to the output
+func (mr *maskReader) flush(n int) {
+	if n <= 0 {
+		return
+	}
+	mr.out = append(mr.out, mr.m.Redact(string(mr.in[:n]), mr.detectors...)...)
+	mr.in = mr.in[n:]
+}
+
+// NewMaskReader return a reader which Redact() the data read from r with the detectors,
+// a match split across reads of r is still masked.
+func NewMaskReader(r io.Reader, detectors ...Detector) io.Reader {
+	return defaultMasker().NewMaskReader(r, detectors...)
+}
+
+// Redact find sensitive information in free text with the detectors, and mask each match with the mask type of the detector
+//
+// Example:
+//   input: contact ggw.chang@gmail.com or 0987654321
+//   output: contact ggw****ng@gmail.com or 0987***321
+func Redact(s string, detectors ...Detector) string {
+	return defaultMasker().Redact(s, detectors...)
+}
+
+// CreditCardsInText find the card numbers of 13 to 19 digits in free text, and mask each one keeping the first 6
+// and the last 4 digits
+func CreditCardsInText(s string) string {
+	return defaultMasker().CreditCardsInText(s)
+}
@@ -0,0 +1,239 @@
+package masker
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestMasker_Redact(t *testing.T) {
+	type args struct {
+		s         string
+		detectors []Detector
+	}
+	tests := []struct {
+		name string
+		m    *Masker
+		args args
+		want string
+	}{
+		{
+			name: "Empty Input",
+			m:    New(),
+			args: args{
+				s: "",
+			},
+			want: "",
+		},
+		{
+			name: "Default Detectors",
+			m:    New(),
+			args: args{
+				s: "contact ggw.chang@gmail.com, 0987654321 or (02)2799-3078",
+			},
+			want: "contact ggw****ng@gmail.com, 0987***321 or (02)2799-****",
+		},
+		{
+			name: "Given Detectors",
+			m:    New(),
+			args: args{
+				s:         "contact ggw.chang@gmail.com or 0987654321",
+				detectors: []Detector{MobileDetector},
+			},
+			want: "contact ggw.chang@gmail.com or 0987***321",
+		},
+		{
+			name: "Labels",
+			m: New(WithRedactLabels(map[string]string{
+				MEmail:  "[EMAIL REDACTED]",
+				MMobile: "[PHONE]",
+			})),
+			args: args{
+				s: "mail a@gmail.com, b@yahoo.com.tw or call 0987654321",
+			},
+			want: "mail [EMAIL REDACTED], [EMAIL REDACTED] or call [PHONE]",
+		},
+		{
+			name: "Labels Partial",
+			m: New(WithRedactLabels(map[string]string{
+				MMobile: "[PHONE]",
+			})),
+			args: args{
+				s: "mail ggw.chang@gmail.com or call 0987654321",
+			},
+			want: "mail ggw****ng@gmail.com or call [PHONE]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Redact(tt.args.s, tt.args.detectors...); got != tt.want {
+				t.Errorf("Masker.Redact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMasker_NewMaskReader(t *testing.T) {
+	long := strings.Repeat("lorem ipsum dolor sit amet ", 20)
+
+	type args struct {
+		r         io.Reader
+		detectors []Detector
+	}
+	tests := []struct {
+		name string
+		m    *Masker
+		args args
+		want string
+	}{
+		{
+			name: "Empty Input",
+			m:    New(),
+			args: args{
+				r: strings.NewReader(""),
+			},
+			want: "",
+		},
+		{
+			name: "Email Split Across Reads",
+			m:    New(),
+			args: args{
+				r: iotest.OneByteReader(strings.NewReader("mail ggw.chang@gmail.com now")),
+			},
+			want: "mail ggw****ng@gmail.com now",
+		},
+		{
+			name: "Long Input Split Across Reads",
+			m:    New(),
+			args: args{
+				r: iotest.HalfReader(strings.NewReader(long + "ggw.chang@gmail.com " + long + "0987654321 " + long)),
+			},
+			want: long + "ggw****ng@gmail.com " + long + "0987***321 " + long,
+		},
+		{
+			name: "Given Detectors",
+			m:    New(),
+			args: args{
+				r:         iotest.OneByteReader(strings.NewReader("ggw.chang@gmail.com 0987654321")),
+				detectors: []Detector{MobileDetector},
+			},
+			want: "ggw.chang@gmail.com 0987***321",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ioutil.ReadAll(tt.m.NewMaskReader(tt.args.r, tt.args.detectors...))
+			if err != nil {
+				t.Errorf("Masker.NewMaskReader() error = %v", err)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("Masker.NewMaskReader() = %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestMasker_CreditCardsInText(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		m    *Masker
+		args args
+		want string
+	}{
+		{
+			name: "Empty Input",
+			m:    New(),
+			args: args{
+				s: "",
+			},
+			want: "",
+		},
+		{
+			name: "One Card",
+			m:    New(),
+			args: args{
+				s: "paid by 4111 1111 1111 1111, thanks",
+			},
+			want: "paid by 4111 11** **** 1111, thanks",
+		},
+		{
+			name: "Multiple Cards",
+			m:    New(),
+			args: args{
+				s: "old 4111-1111-1111-1111 new 378282246310005.",
+			},
+			want: "old 4111-11**-****-1111 new 378282*****0005.",
+		},
+		{
+			name: "No Card",
+			m:    New(),
+			args: args{
+				s: "call 0987654321 or order 123456789012",
+			},
+			want: "call 0987654321 or order 123456789012",
+		},
+		{
+			name: "Too Long Run",
+			m:    New(),
+			args: args{
+				s: "id 12345678901234567890",
+			},
+			want: "id 12345678901234567890",
+		},
+		{
+			name: "Luhn Check",
+			m:    New(WithLuhnCheck()),
+			args: args{
+				s: "card 4111111111111111 order 4111111111111112",
+			},
+			want: "card 411111******1111 order 4111111111111112",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.CreditCardsInText(tt.args.s); got != tt.want {
+				t.Errorf("Masker.CreditCardsInText() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// chunkReader read at most n bytes at a time
+type chunkReader struct {
+	r io.Reader
+	n int
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	if len(p) > c.n {
+		p = p[:c.n]
+	}
+	return c.r.Read(p)
+}
+
+func TestMasker_NewMaskReader_SpacedMatch(t *testing.T) {
+	in := `{"tel":"(02) 2799-3078","note":"` + strings.Repeat("x", 400) + `"}`
+	want := New().Redact(in)
+	if !strings.Contains(want, "2799-****") {
+		t.Fatalf("Masker.Redact() = %v, the telephone is not masked", want)
+	}
+	for _, n := range []int{1, 7, 64, 4096} {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			got, err := ioutil.ReadAll(New().NewMaskReader(&chunkReader{r: strings.NewReader(in), n: n}))
+			if err != nil {
+				t.Errorf("Masker.NewMaskReader() error = %v", err)
+				return
+			}
+			if string(got) != want {
+				t.Errorf("Masker.NewMaskReader() = %v, want %v", string(got), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package masker
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+)
+
+// WithEncryptionKey set the AES key used by Seal() and Open(), the key must be 16, 24 or 32 bytes
+func WithEncryptionKey(key []byte) Option {
+	return func(m *Masker) {
+		m.key = key
+	}
+}
+
+// Seal return the masked input for display, and the AES-GCM ciphertext (base64) of the input,
+// which can be opened by Open() with the same key for audited re-identification
+//
+// Example:
+//
+//   m := masker.New(masker.WithEncryptionKey(key))
+//   masked, sealed, err := m.Seal("A123456789")
+//   origin, err := m.Open(sealed)
+func (m *Masker) Seal(i string) (masked string, sealed string, err error) {
+	gcm, err := m.gcm()
+	if err != nil {
+		return "", "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", "", err
+	}
+
+	return m.Password(i), base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(i), nil)), nil
+}
+
+// Open decrypt the ciphertext returned by Seal()
+func (m *Masker) Open(sealed string) (string, error) {
+	gcm, err := m.gcm()
+	if err != nil {
+		return "", err
+	}
+
+	b, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", err
+	}
+	if len(b) < gcm.NonceSize() {
+		return "", fmt.Errorf("sealed value is too short")
+	}
+
+	plain, err := gcm.Open(nil, b[:gcm.NonceSize()], b[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+func (m *Masker) gcm() (cipher.AEAD, error) {
+	if len(m.key) == 0 {
+		return nil, fmt.Errorf("encryption key is not set")
+	}
+	block, err := aes.NewCipher(m.key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
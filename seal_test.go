@@ -0,0 +1,136 @@
+package masker
+
+import (
+	"testing"
+)
+
+func TestMasker_Seal(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	type args struct {
+		i string
+	}
+	tests := []struct {
+		name       string
+		m          *Masker
+		args       args
+		wantMasked string
+		wantErr    bool
+	}{
+		{
+			name: "Round Trip",
+			m:    New(WithEncryptionKey(key)),
+			args: args{
+				i: "A123456789",
+			},
+			wantMasked: "************",
+			wantErr:    false,
+		},
+		{
+			name: "Empty Input",
+			m:    New(WithEncryptionKey(key)),
+			args: args{
+				i: "",
+			},
+			wantMasked: "",
+			wantErr:    false,
+		},
+		{
+			name: "No Key",
+			m:    New(),
+			args: args{
+				i: "A123456789",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid Key Size",
+			m:    New(WithEncryptionKey([]byte("short"))),
+			args: args{
+				i: "A123456789",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			masked, sealed, err := tt.m.Seal(tt.args.i)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Masker.Seal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if masked != tt.wantMasked {
+				t.Errorf("Masker.Seal() masked = %v, want %v", masked, tt.wantMasked)
+			}
+			got, err := tt.m.Open(sealed)
+			if err != nil {
+				t.Errorf("Masker.Open() error = %v", err)
+				return
+			}
+			if got != tt.args.i {
+				t.Errorf("Masker.Open() = %v, want %v", got, tt.args.i)
+			}
+		})
+	}
+}
+
+func TestMasker_Open(t *testing.T) {
+	m := New(WithEncryptionKey([]byte("0123456789abcdef0123456789abcdef")))
+	_, sealed, err := m.Seal("A123456789")
+	if err != nil {
+		t.Fatalf("Masker.Seal() error = %v", err)
+	}
+
+	type args struct {
+		sealed string
+	}
+	tests := []struct {
+		name    string
+		m       *Masker
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Wrong Key",
+			m:    New(WithEncryptionKey([]byte("fedcba9876543210fedcba9876543210"))),
+			args: args{
+				sealed: sealed,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Not Base64",
+			m:    m,
+			args: args{
+				sealed: "not-base64!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Too Short",
+			m:    m,
+			args: args{
+				sealed: "AAAA",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Tampered",
+			m:    m,
+			args: args{
+				sealed: sealed[:len(sealed)-4] + "AAAA",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.m.Open(tt.args.sealed); (err != nil) != tt.wantErr {
+				t.Errorf("Masker.Open() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
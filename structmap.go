@@ -0,0 +1,211 @@
+package masker
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// WithJSONKeys make StructToMap() and StructDiff() use the name of the json tag instead of the field name,
+// the fields tagged json:"-" are omitted by StructToMap()
+//
+// Example:
+//
+//	type Foo struct {
+//	    Email string `json:"email_address" mask:"email"`
+//	}
+//
+//	m := masker.New(masker.WithJSONKeys())
+//	t, err := m.StructToMap(&Foo{Email: "ggw.chang@gmail.com"}) // map[email_address:ggw****ng@gmail.com]
+func WithJSONKeys() Option {
+	return func(m *Masker) {
+		m.jsonKeys = true
+	}
+}
+
+// fieldKey return the key of the field in the outputs, false if the field should be omitted
+func (m *Masker) fieldKey(f reflect.StructField) (string, bool) {
+	if !m.jsonKeys {
+		return f.Name, true
+	}
+	return tagKey(f, "json", f.Name)
+}
+
+// tagKey return the name of the tag of the field, or def if the tag has no name, false if the tag is "-"
+func tagKey(f reflect.StructField, tag string, def string) (string, bool) {
+	t := f.Tag.Get(tag)
+	if t == "-" {
+		return "", false
+	}
+	if name := strings.Split(t, ",")[0]; len(name) > 0 {
+		return name, true
+	}
+	return def, true
+}
+
+// StructToMap mask the struct like Struct(), and return a map of field name to masked value,
+// nested structs are converted into nested maps, the other values are copied.
+// The empty fields with the omitempty option of the json tag, like json:",omitempty", are omitted like json.Marshal.
+//
+// Example:
+//
+//	type Foo struct {
+//	    Name  string `mask:"name"`
+//	    Qoo   *Qoo   `mask:"struct"`
+//	}
+//
+//	t, err := m.StructToMap(&Foo{Name: "ggwhite", Qoo: &Qoo{...}})
+//
+//	fmt.Println(t) // map[Name:g**hite Qoo:map[...]]
+func (m *Masker) StructToMap(s interface{}) (map[string]interface{}, error) {
+	t, err := m.Struct(s)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(t).Elem()
+	return m.structToMap(v, m.fieldKey, "json"), nil
+}
+
+// structToMap convert the struct into a map with the keys of fieldKey,
+// the empty fields with the omitempty option of the omitTag tag are omitted
+func (m *Masker) structToMap(v reflect.Value, fieldKey func(reflect.StructField) (string, bool), omitTag string) map[string]interface{} {
+	ans := make(map[string]interface{}, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if len(f.PkgPath) > 0 {
+			continue
+		}
+		key, ok := fieldKey(f)
+		if !ok {
+			continue
+		}
+		if omitEmpty(f, omitTag) && isEmptyValue(v.Field(i)) {
+			continue
+		}
+		ans[key] = m.mapValue(v.Field(i), fieldKey, omitTag)
+	}
+	return ans
+}
+
+// mapValue convert a nested struct or pointer to struct into a map, and return other values as they are
+func (m *Masker) mapValue(v reflect.Value, fieldKey func(reflect.StructField) (string, bool), omitTag string) interface{} {
+	if v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Struct && !marshaler(v) {
+		return m.structToMap(v.Elem(), fieldKey, omitTag)
+	}
+	if v.Kind() == reflect.Struct && !marshaler(v) {
+		return m.structToMap(v, fieldKey, omitTag)
+	}
+	return v.Interface()
+}
+
+// omitEmpty report whether the tag of the field has the omitempty option
+func omitEmpty(f reflect.StructField, tag string) bool {
+	opts := strings.Split(f.Tag.Get(tag), ",")
+	for _, opt := range opts[1:] {
+		if opt == "omitempty" {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmptyValue report whether the value is empty like the omitempty option of encoding/json,
+// a struct is never empty
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
+
+// StructToFlatMap mask the struct like Struct(), and return a flat map of the field paths to the masked values
+// for the search and the log systems, the paths are like the ones of StructFilter(): the nested structs are joined
+// by dots, and the elements of the collections by the index or the key in brackets, like "Contacts[0].Email".
+// The nil pointers and the empty collections are kept as values, a []byte and the values which marshal themselves,
+// like time.Time, are not flattened. The keys of the WithJSONKeys option are used.
+//
+// Example:
+//
+//	t, err := m.StructToFlatMap(&Foo{User: User{Contact: Contact{Email: "ggw.chang@gmail.com"}}})
+//
+//	fmt.Println(t) // map[User.Contact.Email:ggw****ng@gmail.com]
+func (m *Masker) StructToFlatMap(s interface{}) (map[string]interface{}, error) {
+	t, err := m.Struct(s)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(t).Elem()
+	ans := map[string]interface{}{}
+	m.flatten(v, "", ans)
+	return ans, nil
+}
+
+// flatten put the leaves of v into ans with the paths under the prefix
+func (m *Masker) flatten(v reflect.Value, prefix string, ans map[string]interface{}) {
+	switch {
+	case v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Struct && !marshaler(v):
+		m.flatten(v.Elem(), prefix, ans)
+	case v.Kind() == reflect.Struct && !marshaler(v):
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if len(f.PkgPath) > 0 {
+				continue
+			}
+			key, ok := m.fieldKey(f)
+			if !ok {
+				continue
+			}
+			if len(prefix) > 0 {
+				key = prefix + "." + key
+			}
+			m.flatten(v.Field(i), key, ans)
+		}
+	case (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Len() > 0 && v.Type().Elem().Kind() != reflect.Uint8:
+		for i := 0; i < v.Len(); i++ {
+			m.flatten(v.Index(i), fmt.Sprintf("%s[%d]", prefix, i), ans)
+		}
+	case v.Kind() == reflect.Map && v.Len() > 0:
+		iter := v.MapRange()
+		for iter.Next() {
+			m.flatten(iter.Value(), fmt.Sprintf("%s[%v]", prefix, iter.Key()), ans)
+		}
+	case v.Kind() == reflect.Interface && !v.IsNil():
+		m.flatten(v.Elem(), prefix, ans)
+	default:
+		ans[prefix] = v.Interface()
+	}
+}
+
+// marshaler report whether the value marshal itself, like time.Time, and should not be converted into a map
+func marshaler(v reflect.Value) bool {
+	t := v.Type()
+	if t.Kind() != reflect.Ptr {
+		t = reflect.PtrTo(t)
+	}
+	return t.Implements(reflect.TypeOf((*json.Marshaler)(nil)).Elem()) ||
+		t.Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem())
+}
+
+// StructToMap mask the struct like Struct(), and return a map of field name to masked value,
+// nested structs are converted into nested maps, the other values are copied
+func StructToMap(s interface{}) (map[string]interface{}, error) {
+	return defaultMasker().StructToMap(s)
+}
+
+// StructToFlatMap mask the struct like Struct(), and return a flat map of the field paths to the masked values
+func StructToFlatMap(s interface{}) (map[string]interface{}, error) {
+	return defaultMasker().StructToFlatMap(s)
+}
@@ -0,0 +1,308 @@
+package masker
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMasker_StructToMap(t *testing.T) {
+	type Contact struct {
+		Email  string `mask:"email"`
+		Mobile string `mask:"mobile"`
+	}
+	type User struct {
+		Name     string   `mask:"name"`
+		Age      int      `mask:"name"`
+		Contact  Contact  `mask:"struct"`
+		Backup   *Contact `mask:"struct"`
+		Empty    *Contact `mask:"struct"`
+		Untagged Contact
+		Tags     []string
+		Created  time.Time
+		password string
+	}
+
+	created := time.Date(2019, 4, 13, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		s interface{}
+	}
+	tests := []struct {
+		name    string
+		m       *Masker
+		args    args
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "Nil Input",
+			m:    New(),
+			args: args{
+				s: nil,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Not A Struct",
+			m:    New(),
+			args: args{
+				s: "ggwhite",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Nested Struct",
+			m:    New(),
+			args: args{
+				s: &User{
+					Name: "ggwhite",
+					Age:  18,
+					Contact: Contact{
+						Email:  "ggw.chang@gmail.com",
+						Mobile: "0987987987",
+					},
+					Backup: &Contact{
+						Email: "qq@gmail.com",
+					},
+					Untagged: Contact{
+						Email: "qq@gmail.com",
+					},
+					Tags:     []string{"a"},
+					Created:  created,
+					password: "abcde",
+				},
+			},
+			want: map[string]interface{}{
+				"Name": "g**hite",
+				"Age":  18,
+				"Contact": map[string]interface{}{
+					"Email":  "ggw****ng@gmail.com",
+					"Mobile": "0987***987",
+				},
+				"Backup": map[string]interface{}{
+					"Email":  "qq****@gmail.com",
+					"Mobile": "",
+				},
+				"Empty": (*Contact)(nil),
+				"Untagged": map[string]interface{}{
+					"Email":  "qq@gmail.com",
+					"Mobile": "",
+				},
+				"Tags":    []string{"a"},
+				"Created": created,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.StructToMap(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Masker.StructToMap() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Masker.StructToMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMasker_StructToMap_JSONKeys(t *testing.T) {
+	type Contact struct {
+		Email string `json:"email_address" mask:"email"`
+	}
+	type User struct {
+		Name     string   `json:"name,omitempty" mask:"name"`
+		Contact  *Contact `json:"contact" mask:"struct"`
+		Password string   `json:"-" mask:"password"`
+		Age      int      `json:",omitempty"`
+	}
+
+	type args struct {
+		s interface{}
+	}
+	tests := []struct {
+		name    string
+		m       *Masker
+		args    args
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "JSON Keys",
+			m:    New(WithJSONKeys()),
+			args: args{
+				s: &User{
+					Name:     "ggwhite",
+					Contact:  &Contact{Email: "ggw.chang@gmail.com"},
+					Password: "abcde",
+					Age:      18,
+				},
+			},
+			want: map[string]interface{}{
+				"name": "g**hite",
+				"contact": map[string]interface{}{
+					"email_address": "ggw****ng@gmail.com",
+				},
+				"Age": 18,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Field Names",
+			m:    New(),
+			args: args{
+				s: &User{
+					Name:     "ggwhite",
+					Contact:  &Contact{Email: "ggw.chang@gmail.com"},
+					Password: "abcde",
+				},
+			},
+			want: map[string]interface{}{
+				"Name": "g**hite",
+				"Contact": map[string]interface{}{
+					"Email": "ggw****ng@gmail.com",
+				},
+				"Password": "************",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Omit Empty",
+			m:    New(WithJSONKeys()),
+			args: args{
+				s: &User{
+					Contact: &Contact{},
+				},
+			},
+			want: map[string]interface{}{
+				"contact": map[string]interface{}{
+					"email_address": "",
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.StructToMap(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Masker.StructToMap() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Masker.StructToMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMasker_StructToFlatMap(t *testing.T) {
+	type Contact struct {
+		Email  string `json:"email" mask:"email"`
+		Mobile string `json:"mobile" mask:"mobile"`
+	}
+	type User struct {
+		Name     string             `json:"name" mask:"name"`
+		Contact  Contact            `json:"contact" mask:"struct"`
+		Contacts []*Contact         `json:"contacts" mask:"struct"`
+		Labels   map[string]string  `json:"labels"`
+		Backup   *Contact           `json:"backup" mask:"struct"`
+		Tags     []string           `json:"tags"`
+		Extra    map[string]Contact `json:"-"`
+	}
+	type Event struct {
+		User    User      `json:"user" mask:"struct"`
+		Created time.Time `json:"created"`
+		Raw     []byte    `json:"raw"`
+	}
+
+	created := time.Date(2019, 4, 13, 0, 0, 0, 0, time.UTC)
+	input := func() *Event {
+		return &Event{
+			User: User{
+				Name:     "ggwhite",
+				Contact:  Contact{Email: "ggw.chang@gmail.com", Mobile: "0987987987"},
+				Contacts: []*Contact{{Email: "qq@gmail.com"}, nil},
+				Labels:   map[string]string{"team": "core"},
+			},
+			Created: created,
+			Raw:     []byte("abc"),
+		}
+	}
+
+	type args struct {
+		s interface{}
+	}
+	tests := []struct {
+		name    string
+		m       *Masker
+		args    args
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:    "Nil Input",
+			m:       New(),
+			args:    args{s: nil},
+			wantErr: true,
+		},
+		{
+			name:    "Not A Struct",
+			m:       New(),
+			args:    args{s: &[]string{"ggwhite"}},
+			wantErr: true,
+		},
+		{
+			name: "Field Names",
+			m:    New(),
+			args: args{s: input()},
+			want: map[string]interface{}{
+				"User.Name":               "g**hite",
+				"User.Contact.Email":      "ggw****ng@gmail.com",
+				"User.Contact.Mobile":     "0987***987",
+				"User.Contacts[0].Email":  "qq****@gmail.com",
+				"User.Contacts[0].Mobile": "",
+				"User.Contacts[1]":        (*Contact)(nil),
+				"User.Labels[team]":       "core",
+				"User.Backup":             (*Contact)(nil),
+				"User.Tags":               []string(nil),
+				"User.Extra":              map[string]Contact(nil),
+				"Created":                 created,
+				"Raw":                     []byte("abc"),
+			},
+		},
+		{
+			name: "JSON Keys",
+			m:    New(WithJSONKeys()),
+			args: args{s: &Event{User: User{Contact: Contact{Email: "ggw.chang@gmail.com"}}}},
+			want: map[string]interface{}{
+				"user.name":           "",
+				"user.contact.email":  "ggw****ng@gmail.com",
+				"user.contact.mobile": "",
+				"user.contacts":       []*Contact(nil),
+				"user.labels":         map[string]string(nil),
+				"user.backup":         (*Contact)(nil),
+				"user.tags":           []string(nil),
+				"created":             time.Time{},
+				"raw":                 []byte(nil),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.StructToFlatMap(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Masker.StructToFlatMap() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Masker.StructToFlatMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
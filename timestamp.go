@@ -0,0 +1,87 @@
+package masker
+
+import (
+	"strings"
+	"time"
+)
+
+// Granularity is the finest component of a timestamp kept by Timestamp()
+type Granularity int
+
+// Granularities of Timestamp()
+const (
+	Year Granularity = iota + 1
+	Month
+	Day
+	Hour
+)
+
+// timestampLayouts are the formats Timestamp() can parse, the date is always the first 10 letters
+var timestampLayouts = []struct {
+	layout string
+	zone   bool
+}{
+	{time.RFC3339Nano, true},
+	{"2006-01-02T15:04:05", false},
+	{"2006-01-02 15:04:05Z07:00", true},
+	{"2006-01-02 15:04:05", false},
+	{"2006/01/02 15:04:05", false},
+	{"2006-01-02", false},
+	{"2006/01/02", false},
+}
+
+// Timestamp keep the components of the timestamp up to the granularity, and mask the finer ones,
+// RFC3339 and the common "2006-01-02 15:04:05" like formats are supported, the time zone is kept.
+// The input is returned as it is if it can not be parsed.
+//
+// Example:
+//
+//	input: 2024-03-15T14:23:00Z, Day
+//	output: 2024-03-15T**:**:**Z
+func (m *Masker) Timestamp(i string, keep Granularity) string {
+	for _, l := range timestampLayouts {
+		if _, err := time.Parse(l.layout, i); err != nil {
+			continue
+		}
+
+		end := len(i)
+		if l.zone {
+			if strings.HasSuffix(i, "Z") {
+				end--
+			} else {
+				end -= len("-07:00")
+			}
+		}
+
+		start := 0
+		switch keep {
+		case Year:
+			start = 4
+		case Month:
+			start = 7
+		case Day:
+			start = 10
+		case Hour:
+			start = 13
+		}
+
+		b := []byte(i)
+		for idx := start; idx < end; idx++ {
+			if b[idx] >= '0' && b[idx] <= '9' {
+				b[idx] = '*'
+			}
+		}
+		return m.renderMask(string(b))
+	}
+	return i
+}
+
+// Timestamp keep the components of the timestamp up to the granularity, and mask the finer ones
+//
+// Example:
+//
+//	input: 2024-03-15T14:23:00Z, Day
+//	output: 2024-03-15T**:**:**Z
+func Timestamp(i string, keep Granularity) string {
+	return defaultMasker().Timestamp(i, keep)
+}
@@ -0,0 +1,125 @@
+package masker
+
+import (
+	"testing"
+)
+
+func TestMasker_Timestamp(t *testing.T) {
+	type args struct {
+		i    string
+		keep Granularity
+	}
+	tests := []struct {
+		name string
+		m    *Masker
+		args args
+		want string
+	}{
+		{
+			name: "Empty Input",
+			m:    New(),
+			args: args{
+				i:    "",
+				keep: Day,
+			},
+			want: "",
+		},
+		{
+			name: "Unparseable",
+			m:    New(),
+			args: args{
+				i:    "yesterday 14:23",
+				keep: Day,
+			},
+			want: "yesterday 14:23",
+		},
+		{
+			name: "Year",
+			m:    New(),
+			args: args{
+				i:    "2024-03-15T14:23:00Z",
+				keep: Year,
+			},
+			want: "2024-**-**T**:**:**Z",
+		},
+		{
+			name: "Mask Char",
+			m:    New(WithMaskChar('#')),
+			args: args{
+				i:    "2024-03-15T14:23:00Z",
+				keep: Day,
+			},
+			want: "2024-03-15T##:##:##Z",
+		},
+		{
+			name: "Collapse Mask",
+			m:    New(WithCollapseMask(true)),
+			args: args{
+				i:    "2024-03-15T14:23:00Z",
+				keep: Day,
+			},
+			want: "2024-03-15T*:*:*Z",
+		},
+		{
+			name: "Month",
+			m:    New(),
+			args: args{
+				i:    "2024-03-15T14:23:00Z",
+				keep: Month,
+			},
+			want: "2024-03-**T**:**:**Z",
+		},
+		{
+			name: "Day",
+			m:    New(),
+			args: args{
+				i:    "2024-03-15T14:23:00Z",
+				keep: Day,
+			},
+			want: "2024-03-15T**:**:**Z",
+		},
+		{
+			name: "Hour",
+			m:    New(),
+			args: args{
+				i:    "2024-03-15T14:23:00Z",
+				keep: Hour,
+			},
+			want: "2024-03-15T14:**:**Z",
+		},
+		{
+			name: "Hour With Offset And Fraction",
+			m:    New(),
+			args: args{
+				i:    "2024-03-15T14:23:00.123+08:00",
+				keep: Hour,
+			},
+			want: "2024-03-15T14:**:**.***+08:00",
+		},
+		{
+			name: "Day Without Zone",
+			m:    New(),
+			args: args{
+				i:    "2024-03-15 14:23:00",
+				keep: Day,
+			},
+			want: "2024-03-15 **:**:**",
+		},
+		{
+			name: "Month Of Date",
+			m:    New(),
+			args: args{
+				i:    "2024/03/15",
+				keep: Month,
+			},
+			want: "2024/03/**",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Timestamp(tt.args.i, tt.args.keep); got != tt.want {
+				t.Errorf("Masker.Timestamp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
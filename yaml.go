@@ -0,0 +1,48 @@
+package masker
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// WithYAMLMarshal set the function StructToYAML() marshal the masked struct with, like yaml.Marshal of gopkg.in/yaml,
+// so the package does not depend on a YAML package
+//
+// Example:
+//
+//	m := masker.New(masker.WithYAMLMarshal(yaml.Marshal))
+func WithYAMLMarshal(marshal func(interface{}) ([]byte, error)) Option {
+	return func(m *Masker) {
+		m.yamlMarshal = marshal
+	}
+}
+
+// yamlKey return the name of the yaml tag of the field, or the lowercased field name like gopkg.in/yaml,
+// false if the field is tagged yaml:"-"
+func yamlKey(f reflect.StructField) (string, bool) {
+	return tagKey(f, "yaml", strings.ToLower(f.Name))
+}
+
+// StructToYAML mask the struct like Struct(), and marshal it to YAML by the function of the WithYAMLMarshal option,
+// the struct is converted like StructToMap() with the keys and the omitempty options of the yaml tags
+//
+// Example:
+//
+//	type Foo struct {
+//	    Email string `yaml:"email_address" mask:"email"`
+//	}
+//
+//	m := masker.New(masker.WithYAMLMarshal(yaml.Marshal))
+//	b, err := m.StructToYAML(&Foo{Email: "ggw.chang@gmail.com"}) // email_address: ggw****ng@gmail.com
+func (m *Masker) StructToYAML(s interface{}) ([]byte, error) {
+	if m.yamlMarshal == nil {
+		return nil, fmt.Errorf("no YAML marshal function, set one by WithYAMLMarshal")
+	}
+	t, err := m.Struct(s)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(t).Elem()
+	return m.yamlMarshal(m.structToMap(v, yamlKey, "yaml"))
+}
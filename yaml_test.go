@@ -0,0 +1,118 @@
+package masker
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// blockYAML marshal the maps of StructToYAML() into the block style YAML, for the tests without a YAML package
+func blockYAML(v interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	var write func(v map[string]interface{}, indent string)
+	write = func(v map[string]interface{}, indent string) {
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if nested, ok := v[k].(map[string]interface{}); ok {
+				fmt.Fprintf(buf, "%s%s:\n", indent, k)
+				write(nested, indent+"  ")
+				continue
+			}
+			fmt.Fprintf(buf, "%s%s: %v\n", indent, k, v[k])
+		}
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected %T", v)
+	}
+	write(m, "")
+	return buf.Bytes(), nil
+}
+
+func TestMasker_StructToYAML(t *testing.T) {
+	type Contact struct {
+		Email string `yaml:"email_address" mask:"email"`
+	}
+	type User struct {
+		Name     string   `yaml:"name" mask:"name"`
+		Mobile   string   `yaml:"mobile,omitempty" mask:"mobile"`
+		Password string   `yaml:"-" mask:"password"`
+		Contact  *Contact `mask:"struct"`
+		Age      int
+	}
+	type args struct {
+		s interface{}
+	}
+	tests := []struct {
+		name    string
+		m       *Masker
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "YAML Keys",
+			m:    New(WithYAMLMarshal(blockYAML)),
+			args: args{
+				s: &User{
+					Name:     "ggwhite",
+					Mobile:   "0987654321",
+					Password: "secret",
+					Contact:  &Contact{Email: "ggw.chang@gmail.com"},
+					Age:      18,
+				},
+			},
+			want: strings.Join([]string{
+				"age: 18",
+				"contact:",
+				"  email_address: ggw****ng@gmail.com",
+				"mobile: 0987***321",
+				"name: g**hite",
+				"",
+			}, "\n"),
+			wantErr: false,
+		},
+		{
+			name: "No Marshal Function",
+			m:    New(),
+			args: args{
+				s: &User{Name: "ggwhite"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Nil Input",
+			m:    New(WithYAMLMarshal(blockYAML)),
+			args: args{
+				s: nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Not A Struct",
+			m:    New(WithYAMLMarshal(blockYAML)),
+			args: args{
+				s: map[string]string{"name": "ggwhite"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.StructToYAML(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Masker.StructToYAML() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("Masker.StructToYAML() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}